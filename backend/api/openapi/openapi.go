@@ -0,0 +1,79 @@
+package openapi
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"gopkg.in/yaml.v3"
+)
+
+// Спецификация пишется вручную, клиент в client/ генерируется из неё
+//
+//go:embed openapi.yaml
+var specYAML []byte
+
+var (
+	specJSON []byte
+	paths    map[string]map[string]any
+	specErr  error
+	once     sync.Once
+)
+
+func load() {
+	var spec any
+	if specErr = yaml.Unmarshal(specYAML, &spec); specErr != nil {
+		return
+	}
+	if specJSON, specErr = json.Marshal(spec); specErr != nil {
+		return
+	}
+	var document struct {
+		Paths map[string]map[string]any `json:"paths"`
+	}
+	specErr = json.Unmarshal(specJSON, &document)
+	paths = document.Paths
+}
+
+// JSON возвращает спецификацию OpenAPI 3 в JSON
+func JSON() ([]byte, error) {
+	once.Do(load)
+	return specJSON, specErr
+}
+
+// Undocumented возвращает маршруты gin, отсутствующие в спецификации, в виде "GET /rest/paste/{id}".
+// Статика (маршруты с *) и HEAD не проверяются
+func Undocumented(routes gin.RoutesInfo) ([]string, error) {
+	once.Do(load)
+	if specErr != nil {
+		return nil, specErr
+	}
+
+	missing := make([]string, 0)
+	for _, route := range routes {
+		if route.Method == http.MethodHead || strings.Contains(route.Path, "*") {
+			continue
+		}
+		path := specPath(route.Path)
+		if _, ok := paths[path][strings.ToLower(route.Method)]; !ok {
+			missing = append(missing, route.Method+" "+path)
+		}
+	}
+	sort.Strings(missing)
+	return missing, nil
+}
+
+// specPath переводит /paste/:id в /paste/{id}
+func specPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i := range segments {
+		if strings.HasPrefix(segments[i], ":") {
+			segments[i] = "{" + segments[i][1:] + "}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
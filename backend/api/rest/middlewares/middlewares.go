@@ -1,13 +1,18 @@
 package middlewares
 
 import (
+	"bytes"
+	"io"
 	"net/http"
 	"pasteGo/backend/api/rest/v1/handlers"
 	auth "pasteGo/backend/api/rest/v1/handlers"
 	"pasteGo/backend/api/rest/v1/types"
+	"pasteGo/backend/db"
+	"pasteGo/backend/db/typesDB"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
 )
 
 // ! dev headers
@@ -15,7 +20,8 @@ func CORSMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.Writer.Header().Set("Access-Control-Allow-Origin", "http://localhost:5173")
 		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With")
+		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With, X-Paste-Password, If-Match")
+		c.Writer.Header().Set("Access-Control-Expose-Headers", "ETag, Retry-After")
 		c.Writer.Header().Set("Access-Control-Allow-Methods", "GET,PUT,POST,DELETE,PATCH,OPTIONS")
 
 		if c.Request.Method == "OPTIONS" {
@@ -101,3 +107,87 @@ func JwtRefreshMiddleware() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// BodyLimitMiddleware отклоняет тело запроса больше limit байт до того,
+// как обработчик начнёт его разбирать. Отрицательный limit - без ограничений
+func BodyLimitMiddleware(limit int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if limit < 0 {
+			c.Next()
+			return
+		}
+
+		tooLarge := types.APIResponse{
+			Code:        types.ErrPasteTooLarge,
+			Explanation: types.ErrPasteTooLargeExp,
+		}
+		if c.Request.ContentLength > limit {
+			c.IndentedJSON(http.StatusRequestEntityTooLarge, tooLarge)
+			c.Abort()
+			return
+		}
+
+		//Content-Length может отсутствовать (chunked), поэтому читаем не больше limit+1
+		body, err := io.ReadAll(io.LimitReader(c.Request.Body, limit+1))
+		if err != nil {
+			c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+				Code:        types.ErrServer,
+				Explanation: types.ErrServerExp,
+			})
+			c.Abort()
+			return
+		}
+		if int64(len(body)) > limit {
+			c.IndentedJSON(http.StatusRequestEntityTooLarge, tooLarge)
+			c.Abort()
+			return
+		}
+
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		c.Next()
+	}
+}
+
+// AdminMiddleware пропускает только администраторов, ставится после JwtMiddleware
+func AdminMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		forbidden := types.APIResponse{
+			Code:        types.ErrAdminRequired,
+			Explanation: types.ErrAdminRequiredExp,
+		}
+
+		rawClaims, exists := c.Get("userClaims")
+		claims, ok := rawClaims.(*jwt.RegisteredClaims)
+		if !exists || !ok {
+			c.IndentedJSON(http.StatusForbidden, forbidden)
+			c.Abort()
+			return
+		}
+
+		DBInstance, err := db.GetDBInstance()
+		if err != nil {
+			c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+				Code:        types.ErrServer,
+				Explanation: types.ErrServerExp,
+			})
+			c.Abort()
+			return
+		}
+		userDB, exists, err := DBInstance.GetUserRecordByUsername(claims.Subject)
+		if err != nil {
+			c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+				Code:        types.ErrServer,
+				Explanation: types.ErrServerExp,
+			})
+			c.Abort()
+			return
+		}
+		if !exists || !typesDB.IntToBool(userDB.Admin) {
+			c.IndentedJSON(http.StatusForbidden, forbidden)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,160 @@
+package middlewares
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"pasteGo/backend/api/rest/v1/types"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// problemWriter придерживает JSON ответы обработчиков v1, чтобы переписать их для v2.
+// Файлы, архивы и прочие не-JSON ответы уходят клиенту без изменений
+type problemWriter struct {
+	gin.ResponseWriter
+	status   int
+	decided  bool
+	buffered bool
+	body     bytes.Buffer
+}
+
+func (w *problemWriter) WriteHeader(code int) {
+	if !w.decided {
+		w.status = code
+	}
+}
+
+func (w *problemWriter) WriteHeaderNow() {
+	w.decide()
+	if !w.buffered {
+		w.ResponseWriter.WriteHeaderNow()
+	}
+}
+
+func (w *problemWriter) Write(data []byte) (int, error) {
+	w.decide()
+	if w.buffered {
+		return w.body.Write(data)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *problemWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+func (w *problemWriter) Status() int {
+	return w.status
+}
+
+func (w *problemWriter) Written() bool {
+	return w.decided
+}
+
+func (w *problemWriter) Flush() {
+	w.decide()
+	if !w.buffered {
+		w.ResponseWriter.Flush()
+	}
+}
+
+// decide выбирает режим по Content-Type, который к первой записи уже выставлен
+func (w *problemWriter) decide() {
+	if w.decided {
+		return
+	}
+	w.decided = true
+	contentType := w.Header().Get("Content-Type")
+	w.buffered = contentType == "" || strings.HasPrefix(contentType, "application/json")
+	if !w.buffered {
+		w.ResponseWriter.WriteHeader(w.status)
+	}
+}
+
+// ProblemMiddleware переводит ответы types.APIResponse в формат /rest/v2:
+// успешный ответ - сам ресурс из Message (без него 204), ошибка - RFC 7807 problem+json
+func ProblemMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		original := c.Writer
+		writer := &problemWriter{ResponseWriter: original, status: http.StatusOK}
+		c.Writer = writer
+		c.Next()
+		c.Writer = original
+
+		if writer.decided && !writer.buffered {
+			return
+		}
+
+		//Content-Type обработчика заменяется ниже, gin не перезаписывает уже выставленный
+		contentType := writer.Header().Get("Content-Type")
+		writer.Header().Del("Content-Type")
+		body := writer.body.Bytes()
+		if len(body) == 0 {
+			if writer.status >= http.StatusBadRequest {
+				writeProblem(c, types.NewProblem(0, "", writer.status), c.Errors.Last())
+				return
+			}
+			if writer.status == http.StatusOK {
+				writer.status = http.StatusNoContent
+			}
+			c.Status(writer.status)
+			c.Writer.WriteHeaderNow()
+			return
+		}
+
+		response := struct {
+			Code        *int            `json:"code"`
+			Explanation string          `json:"explanation"`
+			Message     json.RawMessage `json:"message"`
+		}{}
+		if err := json.Unmarshal(body, &response); err != nil || response.Code == nil {
+			//Не APIResponse (например /openapi.json) - отдаём как есть
+			c.Data(writer.status, contentType, body)
+			return
+		}
+
+		if *response.Code != types.OperationSuccess {
+			problem := types.NewProblem(*response.Code, response.Explanation, writer.status)
+			if len(response.Message) > 0 {
+				problem.Details = response.Message
+			}
+			writeProblem(c, problem, nil)
+			return
+		}
+
+		//Список вставок в v1 отвечает 201, чтение в v2 ничего не создаёт
+		if c.Request.Method == http.MethodGet && writer.status == http.StatusCreated {
+			writer.status = http.StatusOK
+		}
+		if len(response.Message) == 0 || string(response.Message) == "null" {
+			if writer.status == http.StatusOK {
+				writer.status = http.StatusNoContent
+			}
+			c.Status(writer.status)
+			c.Writer.WriteHeaderNow()
+			return
+		}
+		indented := bytes.Buffer{}
+		if err := json.Indent(&indented, response.Message, "", "    "); err != nil {
+			indented.Write(response.Message)
+		}
+		c.Data(writer.status, "application/json; charset=utf-8", indented.Bytes())
+	}
+}
+
+func writeProblem(c *gin.Context, problem types.Problem, err *gin.Error) {
+	if err != nil {
+		problem.Detail = err.Error()
+	}
+	problem.Instance = c.Request.URL.Path
+
+	body, marshalErr := json.MarshalIndent(problem, "", "    ")
+	if marshalErr != nil {
+		c.Status(problem.Status)
+		c.Writer.WriteHeaderNow()
+		return
+	}
+	c.Data(problem.Status, types.ContentTypeProblem, body)
+}
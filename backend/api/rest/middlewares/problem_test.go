@@ -0,0 +1,56 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"pasteGo/backend/api/rest/v1/types"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestProblemMiddlewareStatus(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	//Коды ответов v1 сохранены: список отвечает 201, сбой выдачи токенов при регистрации - 201 с ErrServer
+	created := func(c *gin.Context) {
+		c.IndentedJSON(http.StatusCreated, types.APIResponse{
+			Code:        types.OperationSuccess,
+			Explanation: types.OperationSuccessExp,
+			Message:     gin.H{"id": "x"},
+		})
+	}
+	failed := func(c *gin.Context) {
+		c.IndentedJSON(http.StatusCreated, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+	}
+
+	tests := []struct {
+		name    string
+		method  string
+		handler gin.HandlerFunc
+		v1      int
+		v2      int
+	}{
+		{"list", http.MethodGet, created, http.StatusCreated, http.StatusOK},
+		{"create", http.MethodPost, created, http.StatusCreated, http.StatusCreated},
+		{"error", http.MethodPost, failed, http.StatusCreated, http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Handle(tt.method, "/v1", tt.handler)
+			router.Handle(tt.method, "/v2", ProblemMiddleware(), tt.handler)
+
+			for path, want := range map[string]int{"/v1": tt.v1, "/v2": tt.v2} {
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, httptest.NewRequest(tt.method, path, nil))
+				if w.Code != want {
+					t.Errorf("%s %s: status %d, want %d: %s", tt.method, path, w.Code, want, w.Body)
+				}
+			}
+		})
+	}
+}
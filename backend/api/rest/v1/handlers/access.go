@@ -0,0 +1,362 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+	"pasteGo/backend/api/rest/v1/types"
+	"pasteGo/backend/auth"
+	"pasteGo/backend/db"
+	"pasteGo/backend/db/typesDB"
+	"pasteGo/backend/ratelimit"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// getCurrentUser находит пользователя по claims, положенным JwtMiddleware.
+// Если пользователя нет, ответ уже записан и возвращается false.
+func getCurrentUser(c *gin.Context, DBInstance *db.DBInstance) (typesDB.UserRecord, bool) {
+	rawClaims, exists := c.Get("userClaims")
+	if !exists {
+		c.IndentedJSON(http.StatusUnauthorized, types.APIResponse{
+			Code:        types.ErrGetCookies,
+			Explanation: types.ErrGetCookiesExp,
+		})
+		DumpCookies(c)
+		return typesDB.UserRecord{}, false
+	}
+
+	claims, ok := rawClaims.(*jwt.RegisteredClaims)
+	if !ok {
+		c.IndentedJSON(http.StatusUnauthorized, types.APIResponse{
+			Code:        types.ErrJWTProcessing,
+			Explanation: types.ErrJWTProcessingExp,
+		})
+		DumpCookies(c)
+		return typesDB.UserRecord{}, false
+	}
+
+	userDB, exists, err := DBInstance.GetUserRecordByUsername(claims.Subject)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return typesDB.UserRecord{}, false
+	}
+	if !exists {
+		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
+			Code:        types.ErrUserNotFound,
+			Explanation: types.ErrUserNotFoundExp,
+		})
+		return typesDB.UserRecord{}, false
+	}
+	return userDB, true
+}
+
+// getAccessiblePaste проверяет срок жизни, видимость и пароль вставки.
+// Если доступа нет, ответ уже записан и возвращается false.
+func getAccessiblePaste(c *gin.Context, pasteId string, password string) (typesDB.PasteRecord, typesDB.UserRecord, bool) {
+	return accessiblePaste(c, pasteId, password, true)
+}
+
+// getCopyablePaste - getAccessiblePaste для обработчиков, которые сохраняют копию текста.
+// Ссылка ?link= даёт только просмотр: копия пережила бы её срок и отзыв,
+// поэтому к непубличной вставке нужен доступ автора или выданный через shares
+func getCopyablePaste(c *gin.Context, pasteId string, password string) (typesDB.PasteRecord, typesDB.UserRecord, bool) {
+	return accessiblePaste(c, pasteId, password, false)
+}
+
+func accessiblePaste(c *gin.Context, pasteId string, password string, allowLink bool) (typesDB.PasteRecord, typesDB.UserRecord, bool) {
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return typesDB.PasteRecord{}, typesDB.UserRecord{}, false
+	}
+
+	paste, exist, err := DBInstance.GetPasteRecordById(pasteId)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return typesDB.PasteRecord{}, typesDB.UserRecord{}, false
+	}
+	if !exist {
+		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
+			Code:        types.ErrPasteNotFound,
+			Explanation: types.ErrPasteNotFoundExp,
+		})
+		return typesDB.PasteRecord{}, typesDB.UserRecord{}, false
+	}
+	if paste.Lifetime > 0 && paste.Lifetime < time.Now().Unix() {
+		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
+			Code:        types.ErrPasteNotFound,
+			Explanation: types.ErrPasteNotFoundExp,
+		})
+		deletePaste(paste.Id)
+		return typesDB.PasteRecord{}, typesDB.UserRecord{}, false
+	}
+
+	userDB, exists, err := DBInstance.GetUserRecordById(paste.UserId)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return typesDB.PasteRecord{}, typesDB.UserRecord{}, false
+	}
+	if !exists {
+		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
+			Code:        types.ErrUserNotFound,
+			Explanation: types.ErrUserNotFoundExp,
+		})
+		return typesDB.PasteRecord{}, typesDB.UserRecord{}, false
+	}
+
+	//Непубличную вставку читают автор, те, кому выдан доступ, и гости по ссылке ?link=
+	linkId := ""
+	if !typesDB.IntToBool(paste.Public) {
+		permission := ""
+		viewer, ok := getViewer(c, DBInstance)
+		if ok {
+			if permission, err = pastePermission(DBInstance, paste, viewer); err != nil {
+				c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+					Code:        types.ErrServer,
+					Explanation: types.ErrServerExp,
+				})
+				return typesDB.PasteRecord{}, typesDB.UserRecord{}, false
+			}
+		}
+		token := ""
+		if allowLink {
+			token = c.Query(types.QueryShareLink)
+		}
+		if permission == "" && token == "" {
+			if !ok {
+				DumpCookies(c)
+				c.IndentedJSON(http.StatusUnauthorized, types.APIResponse{
+					Code:        types.ErrNotPublicPaste,
+					Explanation: types.ErrNotPublicPasteExp,
+				})
+				return typesDB.PasteRecord{}, typesDB.UserRecord{}, false
+			}
+			c.IndentedJSON(http.StatusForbidden, types.APIResponse{
+				Code:        types.ErrPasteNotShared,
+				Explanation: types.ErrPasteNotSharedExp,
+			})
+			return typesDB.PasteRecord{}, typesDB.UserRecord{}, false
+		}
+		if permission == "" {
+			if linkId, err = checkShareLink(DBInstance, paste, token); err != nil {
+				c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+					Code:        types.ErrServer,
+					Explanation: types.ErrServerExp,
+				})
+				return typesDB.PasteRecord{}, typesDB.UserRecord{}, false
+			}
+			if linkId == "" {
+				c.IndentedJSON(http.StatusForbidden, types.APIResponse{
+					Code:        types.ErrShareLinkInvalid,
+					Explanation: types.ErrShareLinkInvalidExp,
+				})
+				return typesDB.PasteRecord{}, typesDB.UserRecord{}, false
+			}
+		}
+	}
+
+	//Первый запрос: на вставке имеется пароль, но пользователь не знает об этом
+	if paste.Password != "" && password == "" {
+		c.IndentedJSON(http.StatusUnauthorized, types.APIResponse{
+			Code:        types.ErrPasswordPaste,
+			Explanation: types.ErrPasswordPasteExp,
+		})
+		return typesDB.PasteRecord{}, typesDB.UserRecord{}, false
+	} else if paste.Password != "" && password != "" { //Второй запрос: указан пароль
+		ipKey, pasteKey := ratelimit.IPKey(c.ClientIP()), ratelimit.PasteKey(paste.Id)
+		if !allowAttempt(c, ipKey, pasteKey) {
+			return typesDB.PasteRecord{}, typesDB.UserRecord{}, false
+		}
+		match, rehash := auth.CheckPassword(paste.Password, password)
+		if !match {
+			failAttempt(c, DBInstance, "", ipKey, pasteKey)
+			c.IndentedJSON(http.StatusUnauthorized, types.APIResponse{
+				Code:        types.ErrWrongPasswordPaste,
+				Explanation: types.ErrWrongPasswordPasteExp,
+			})
+			return typesDB.PasteRecord{}, typesDB.UserRecord{}, false
+		}
+		resetAttempts(pasteKey)
+		if rehash {
+			newHash := auth.HashPassword(password)
+			if err := DBInstance.RehashPastePassword(paste.Id, paste.Password, newHash); err != nil {
+				log.Printf("rehash password of paste %s: %s", paste.Id, err)
+			} else {
+				paste.Password = newHash
+			}
+		}
+	}
+
+	//Просмотр по ссылке засчитывается только после проверки пароля
+	if linkId != "" {
+		used, err := DBInstance.UseShareLink(linkId, paste.Id, time.Now().Unix())
+		if err != nil {
+			c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+				Code:        types.ErrServer,
+				Explanation: types.ErrServerExp,
+			})
+			return typesDB.PasteRecord{}, typesDB.UserRecord{}, false
+		}
+		if !used {
+			c.IndentedJSON(http.StatusForbidden, types.APIResponse{
+				Code:        types.ErrShareLinkInvalid,
+				Explanation: types.ErrShareLinkInvalidExp,
+			})
+			return typesDB.PasteRecord{}, typesDB.UserRecord{}, false
+		}
+	}
+
+	return paste, userDB, true
+}
+
+// getViewer находит пользователя по действующему access-токену, ответ не пишет.
+// false - токена нет, он недействителен или пользователь удалён
+func getViewer(c *gin.Context, DBInstance *db.DBInstance) (typesDB.UserRecord, bool) {
+	accessToken, err := c.Cookie(types.CookieAccessToken)
+	if err != nil {
+		return typesDB.UserRecord{}, false
+	}
+	claims, err := ParseClaims(accessToken)
+	if err != nil || claims.ExpiresAt.Unix() < time.Now().Unix() {
+		return typesDB.UserRecord{}, false
+	}
+	userDB, exists, err := DBInstance.GetUserRecordByUsername(claims.Subject)
+	if err != nil || !exists {
+		return typesDB.UserRecord{}, false
+	}
+	return userDB, true
+}
+
+// pastePermission - право userDB на вставку: owner для автора, edit или read по выданному
+// доступу, пустая строка - доступа нет. Вставкой команды распоряжаются её владельцы и редакторы,
+// зрители её читают; автор, ушедший из команды, теряет права на неё
+func pastePermission(DBInstance *db.DBInstance, paste typesDB.PasteRecord, userDB typesDB.UserRecord) (string, error) {
+	role := ""
+	if paste.TeamId != "" {
+		var err error
+		if role, err = DBInstance.GetTeamRole(paste.TeamId, userDB.Id); err != nil {
+			return "", err
+		}
+		if role == typesDB.TeamRoleOwner || role == typesDB.TeamRoleEditor {
+			return typesDB.PermissionOwner, nil
+		}
+	} else if paste.UserId == userDB.Id {
+		return typesDB.PermissionOwner, nil
+	}
+
+	permission, err := DBInstance.GetPastePermission(paste.Id, userDB.Id)
+	if permission == "" && role == typesDB.TeamRoleViewer {
+		permission = typesDB.PermissionRead
+	}
+	return permission, err
+}
+
+// getOwnedPaste возвращает вставку, только если userDB - её автор, а для вставки команды -
+// владелец или редактор команды
+func getOwnedPaste(c *gin.Context, DBInstance *db.DBInstance, pasteId string, userDB typesDB.UserRecord) (typesDB.PasteRecord, bool) {
+	paste, exists, err := DBInstance.GetPasteRecordById(pasteId)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return typesDB.PasteRecord{}, false
+	}
+	if !exists || (paste.Lifetime > 0 && paste.Lifetime < time.Now().Unix()) {
+		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
+			Code:        types.ErrPasteNotFound,
+			Explanation: types.ErrPasteNotFoundExp,
+		})
+		return typesDB.PasteRecord{}, false
+	}
+	permission, err := pastePermission(DBInstance, paste, userDB)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return typesDB.PasteRecord{}, false
+	}
+	if permission != typesDB.PermissionOwner {
+		c.IndentedJSON(http.StatusForbidden, types.APIResponse{
+			Code:        types.ErrPasteAccessDenied,
+			Explanation: types.ErrPasteAccessDeniedExp,
+		})
+		return typesDB.PasteRecord{}, false
+	}
+	return paste, true
+}
+
+// getPasteAuthor возвращает автора вставки. Правка чужой вставки (по доступу edit или
+// в команде) сохраняется от его имени и расходует его квоту
+func getPasteAuthor(c *gin.Context, DBInstance *db.DBInstance, paste typesDB.PasteRecord, userDB typesDB.UserRecord) (typesDB.UserRecord, bool) {
+	if paste.UserId == userDB.Id {
+		return userDB, true
+	}
+	author, exists, err := DBInstance.GetUserRecordById(paste.UserId)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return typesDB.UserRecord{}, false
+	}
+	if !exists {
+		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
+			Code:        types.ErrUserNotFound,
+			Explanation: types.ErrUserNotFoundExp,
+		})
+		return typesDB.UserRecord{}, false
+	}
+	return author, true
+}
+
+// getEditablePaste возвращает вставку, если userDB - её автор, участник команды с правом
+// правки или ему выдан доступ edit, и право пользователя на неё
+func getEditablePaste(c *gin.Context, DBInstance *db.DBInstance, pasteId string, userDB typesDB.UserRecord) (typesDB.PasteRecord, string, bool) {
+	paste, exists, err := DBInstance.GetPasteRecordById(pasteId)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return typesDB.PasteRecord{}, "", false
+	}
+	if !exists || (paste.Lifetime > 0 && paste.Lifetime < time.Now().Unix()) {
+		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
+			Code:        types.ErrPasteNotFound,
+			Explanation: types.ErrPasteNotFoundExp,
+		})
+		return typesDB.PasteRecord{}, "", false
+	}
+	permission, err := pastePermission(DBInstance, paste, userDB)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return typesDB.PasteRecord{}, "", false
+	}
+	if permission != typesDB.PermissionOwner && permission != typesDB.PermissionEdit {
+		c.IndentedJSON(http.StatusForbidden, types.APIResponse{
+			Code:        types.ErrPasteAccessDenied,
+			Explanation: types.ErrPasteAccessDeniedExp,
+		})
+		return typesDB.PasteRecord{}, "", false
+	}
+	return paste, permission, true
+}
@@ -0,0 +1,152 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"pasteGo/backend/api/rest/v1/types"
+	"pasteGo/backend/db/typesDB"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestPastePasswordRehash(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	DBInstance := openTestDB(t)
+
+	if _, err := DBInstance.AddUserRecord(&typesDB.UserRecord{Id: "owner-id", Username: "owner"}); err != nil {
+		t.Fatal(err)
+	}
+	//Хеш из версий до argon2id: SHA-256 без соли
+	legacy := ShaHashing("paste-secret")
+	if _, err := DBInstance.AddPasteRecord(&typesDB.PasteRecord{Id: "locked-paste", UserId: "owner-id", Text: "x", Created: time.Now().Unix(), Lifetime: -1, Password: legacy, Public: 1}); err != nil {
+		t.Fatal(err)
+	}
+	router := gin.New()
+	router.GET("/pastes/:id", ReadPaste)
+	read := func(password string) int {
+		r := httptest.NewRequest(http.MethodGet, "/pastes/locked-paste", nil)
+		r.Header.Set(types.HeaderPastePassword, password)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+		return w.Code
+	}
+	stored := func() string {
+		t.Helper()
+		record, _, err := DBInstance.GetPasteRecordById("locked-paste")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return record.Password
+	}
+
+	if code := read("wrong"); code != http.StatusUnauthorized {
+		t.Fatalf("wrong password: status %d", code)
+	}
+	if stored() != legacy {
+		t.Error("hash changed after a wrong password")
+	}
+	if code := read("paste-secret"); code != http.StatusOK {
+		t.Fatalf("right password: status %d", code)
+	}
+	rehashed := stored()
+	if !strings.HasPrefix(rehashed, "$argon2id$") {
+		t.Fatalf("stored hash after reading = %q", rehashed)
+	}
+	if code := read("paste-secret"); code != http.StatusOK || stored() != rehashed {
+		t.Errorf("read with the new hash: status %d", code)
+	}
+	if code := read("wrong"); code != http.StatusUnauthorized {
+		t.Errorf("wrong password against the new hash: status %d", code)
+	}
+}
+
+func TestPasteShares(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	types.SecretKey = []byte("share-test-secret")
+	DBInstance := openTestDB(t)
+
+	for _, username := range []string{"owner", "stranger", "reader", "editor"} {
+		if _, err := DBInstance.AddUserRecord(&typesDB.UserRecord{Id: username + "-id", Username: username}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := DBInstance.AddPasteRecord(&typesDB.PasteRecord{Id: "private-paste", UserId: "owner-id", Text: "secret text", Created: time.Now().Unix(), Lifetime: -1}); err != nil {
+		t.Fatal(err)
+	}
+	for _, share := range []typesDB.ShareRecord{
+		{Id: "read-share", PasteId: "private-paste", UserId: "reader-id", Permission: typesDB.PermissionRead},
+		{Id: "edit-share", PasteId: "private-paste", UserId: "editor-id", Permission: typesDB.PermissionEdit},
+	} {
+		if _, err := DBInstance.SetShareRecord(&share); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	//Access токен проверяется так же, как в middlewares.JwtMiddleware
+	router := gin.New()
+	authorized := func(c *gin.Context) {
+		accessToken, err := c.Cookie(types.CookieAccessToken)
+		if err != nil {
+			return
+		}
+		if claims, err := ParseClaims(accessToken); err == nil {
+			c.Set("userClaims", claims)
+		}
+	}
+	router.GET("/pastes/:id", ReadPaste)
+	router.PATCH("/pastes/:id", authorized, PatchPaste)
+	request := func(method string, username string, body string) *httptest.ResponseRecorder {
+		t.Helper()
+		tokens, err := GenerateTokens(username)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r := httptest.NewRequest(method, "/pastes/private-paste", strings.NewReader(body))
+		r.Header.Set(types.HeaderIfMatch, "*")
+		r.AddCookie(&http.Cookie{Name: types.CookieAccessToken, Value: tokens.AccessToken})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+		return w
+	}
+	stored := func() typesDB.PasteRecord {
+		t.Helper()
+		record, _, err := DBInstance.GetPasteRecordById("private-paste")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return record
+	}
+
+	//Чужой пользователь не видит непубличную вставку и не меняет её
+	if w := request(http.MethodGet, "stranger", ""); w.Code != http.StatusForbidden || responseCode(t, w) != types.ErrPasteNotShared {
+		t.Errorf("read by a stranger: status %d: %s", w.Code, w.Body)
+	}
+	if w := request(http.MethodPatch, "stranger", `{"text":"stranger text"}`); w.Code != http.StatusForbidden || responseCode(t, w) != types.ErrPasteAccessDenied {
+		t.Errorf("edit by a stranger: status %d: %s", w.Code, w.Body)
+	}
+
+	//Доступ read позволяет только читать
+	if w := request(http.MethodGet, "reader", ""); w.Code != http.StatusOK {
+		t.Errorf("read by a reader: status %d: %s", w.Code, w.Body)
+	}
+	if w := request(http.MethodPatch, "reader", `{"text":"reader text"}`); w.Code != http.StatusForbidden || responseCode(t, w) != types.ErrPasteAccessDenied {
+		t.Errorf("edit by a reader: status %d: %s", w.Code, w.Body)
+	}
+	if record := stored(); record.Text != "secret text" {
+		t.Fatalf("text after rejected edits = %q", record.Text)
+	}
+
+	//Доступ edit позволяет менять содержимое, но не публичность
+	if w := request(http.MethodGet, "editor", ""); w.Code != http.StatusOK {
+		t.Errorf("read by an editor: status %d: %s", w.Code, w.Body)
+	}
+	if w := request(http.MethodPatch, "editor", `{"text":"editor text","public":true}`); w.Code != http.StatusOK {
+		t.Fatalf("edit by an editor: status %d: %s", w.Code, w.Body)
+	}
+	if record := stored(); record.Text != "editor text" || typesDB.IntToBool(record.Public) || record.UserId != "owner-id" {
+		t.Errorf("paste after an edit by an editor = %+v", record)
+	}
+}
@@ -0,0 +1,184 @@
+package handlers
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"pasteGo/backend/api/rest/v1/types"
+	"pasteGo/backend/audit"
+	"pasteGo/backend/db"
+	"pasteGo/backend/db/typesDB"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func GetUserQuota(c *gin.Context) {
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getUserByParam(c, DBInstance)
+	if !ok {
+		return
+	}
+
+	respondUserQuota(c, DBInstance, userDB)
+}
+
+func SetUserQuota(c *gin.Context) {
+	override := types.QuotaOverride{}
+	if err := c.BindJSON(&override); err != nil {
+		return
+	}
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getUserByParam(c, DBInstance)
+	if !ok {
+		return
+	}
+
+	record := typesDB.QuotaRecord{UserId: userDB.Id}
+	if override.MaxPastes != nil {
+		record.MaxPastes = sql.NullInt64{Int64: *override.MaxPastes, Valid: true}
+	}
+	if override.MaxStorage != nil {
+		record.MaxStorage = sql.NullInt64{Int64: *override.MaxStorage, Valid: true}
+	}
+
+	if record.MaxPastes.Valid || record.MaxStorage.Valid {
+		err = DBInstance.SetQuotaRecord(&record)
+	} else {
+		err = DBInstance.DeleteQuotaRecord(userDB.Id)
+	}
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	recordQuotaChange(c, DBInstance, userDB, fmt.Sprintf("maxPastes %s, maxStorage %s", quotaLimit(record.MaxPastes), quotaLimit(record.MaxStorage)))
+
+	respondUserQuota(c, DBInstance, userDB)
+}
+
+func ResetUserQuota(c *gin.Context) {
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getUserByParam(c, DBInstance)
+	if !ok {
+		return
+	}
+
+	if err := DBInstance.DeleteQuotaRecord(userDB.Id); err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	recordQuotaChange(c, DBInstance, userDB, "reset")
+
+	respondUserQuota(c, DBInstance, userDB)
+}
+
+func respondUserQuota(c *gin.Context, DBInstance *db.DBInstance, userDB typesDB.UserRecord) {
+	quota, err := getQuota(DBInstance, userDB.Id)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	record, _, err := DBInstance.GetQuotaRecord(userDB.Id)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	override := types.QuotaOverride{}
+	if record.MaxPastes.Valid {
+		override.MaxPastes = &record.MaxPastes.Int64
+	}
+	if record.MaxStorage.Valid {
+		override.MaxStorage = &record.MaxStorage.Int64
+	}
+
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message: types.UserQuota{
+			Username: userDB.Username,
+			Quota:    quota,
+			Override: override,
+		},
+	})
+}
+
+// recordQuotaChange пишет в аудит изменение лимитов пользователя userDB текущим администратором
+func recordQuotaChange(c *gin.Context, DBInstance *db.DBInstance, userDB typesDB.UserRecord, detail string) {
+	claims, _ := c.Get("userClaims")
+	actor := ""
+	if registered, ok := claims.(*jwt.RegisteredClaims); ok {
+		actor = registered.Subject
+	}
+	recordAudit(c, DBInstance, audit.Event{
+		Actor:  actor,
+		Action: audit.ActionQuotaChange,
+		Target: audit.UserTarget(userDB.Id),
+		Detail: detail,
+	})
+}
+
+func quotaLimit(limit sql.NullInt64) string {
+	if !limit.Valid {
+		return "default"
+	}
+	return strconv.FormatInt(limit.Int64, 10)
+}
+
+// getUserByParam находит пользователя из параметра :username административных маршрутов
+func getUserByParam(c *gin.Context, DBInstance *db.DBInstance) (typesDB.UserRecord, bool) {
+	userDB, exists, err := DBInstance.GetUserRecordByUsername(c.Param("username"))
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return typesDB.UserRecord{}, false
+	}
+	if !exists {
+		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
+			Code:        types.ErrUserNotFound,
+			Explanation: types.ErrUserNotFoundExp,
+		})
+		return typesDB.UserRecord{}, false
+	}
+	return userDB, true
+}
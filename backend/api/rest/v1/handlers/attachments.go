@@ -0,0 +1,310 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"pasteGo/backend/api/rest/v1/types"
+	"pasteGo/backend/config"
+	"pasteGo/backend/db"
+	"pasteGo/backend/db/typesDB"
+	"pasteGo/backend/retention"
+	"pasteGo/backend/storage"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Типы, которые безопасно показывать в браузере прямо на странице.
+// Всё остальное отдаётся как attachment, чтобы загруженный HTML не
+// исполнялся на нашем домене
+var inlineContentTypes = map[string]bool{
+	"image/png":  true,
+	"image/jpeg": true,
+	"image/gif":  true,
+	"image/webp": true,
+	"image/bmp":  true,
+}
+
+func UploadAttachment(c *gin.Context) {
+	//Запас на заголовки multipart сверх самого файла
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, config.MaxAttachmentSize+1<<20)
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.IndentedJSON(http.StatusRequestEntityTooLarge, types.APIResponse{
+				Code:        types.ErrAttachmentTooLarge,
+				Explanation: types.ErrAttachmentTooLargeExp,
+			})
+			return
+		}
+		c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+			Code:        types.ErrAttachmentFile,
+			Explanation: types.ErrAttachmentFileExp,
+		})
+		return
+	}
+	if fileHeader.Size > config.MaxAttachmentSize {
+		c.IndentedJSON(http.StatusRequestEntityTooLarge, types.APIResponse{
+			Code:        types.ErrAttachmentTooLarge,
+			Explanation: types.ErrAttachmentTooLargeExp,
+		})
+		return
+	}
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	store, err := storage.GetBlobStore()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+	paste, ok := getOwnedPaste(c, DBInstance, c.Param("id"), userDB)
+	if !ok {
+		return
+	}
+	if !checkQuota(c, DBInstance, paste.UserId, 0, fileHeader.Size) {
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+			Code:        types.ErrAttachmentFile,
+			Explanation: types.ErrAttachmentFileExp,
+		})
+		return
+	}
+	defer file.Close()
+
+	//Тип определяется по содержимому, заголовку клиента не доверяем
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+			Code:        types.ErrAttachmentFile,
+			Explanation: types.ErrAttachmentFileExp,
+		})
+		return
+	}
+	contentType := http.DetectContentType(head[:n])
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	record := typesDB.AttachmentRecord{
+		Id:          uuid.New().String(),
+		PasteId:     paste.Id,
+		Name:        attachmentName(fileHeader.Filename),
+		ContentType: contentType,
+		Size:        fileHeader.Size,
+		Created:     time.Now().Unix(),
+	}
+
+	if err := store.Put(c.Request.Context(), record.Id, file, record.Size, record.ContentType); err != nil {
+		log.Printf("attachment %s: %s", record.Id, err)
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	created, err := DBInstance.AddAttachmentRecord(&record)
+	if err != nil || !created {
+		store.Delete(c.Request.Context(), record.Id)
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	c.IndentedJSON(http.StatusCreated, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message:     attachmentFromRecord(&record),
+	})
+}
+
+func DownloadAttachment(c *gin.Context) {
+	paste, _, ok := getAccessiblePaste(c, c.Param("id"), c.GetHeader(types.HeaderPastePassword))
+	if !ok {
+		return
+	}
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	store, err := storage.GetBlobStore()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	record, exists, err := DBInstance.GetAttachmentRecordById(c.Param("attachmentId"))
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	if !exists || record.PasteId != paste.Id {
+		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
+			Code:        types.ErrAttachmentNotFound,
+			Explanation: types.ErrAttachmentNotFoundExp,
+		})
+		return
+	}
+
+	blob, err := store.Get(c.Request.Context(), record.Id)
+	if errors.Is(err, storage.ErrNotFound) {
+		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
+			Code:        types.ErrAttachmentNotFound,
+			Explanation: types.ErrAttachmentNotFoundExp,
+		})
+		return
+	}
+	if err != nil {
+		log.Printf("attachment %s: %s", record.Id, err)
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	defer blob.Close()
+
+	disposition := "attachment"
+	if inlineContentTypes[record.ContentType] {
+		disposition = "inline"
+	}
+	c.Header("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": record.Name}))
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Header("Content-Security-Policy", "default-src 'none'; sandbox")
+	c.DataFromReader(http.StatusOK, record.Size, record.ContentType, blob, nil)
+}
+
+func DeleteAttachment(c *gin.Context) {
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+	paste, ok := getOwnedPaste(c, DBInstance, c.Param("id"), userDB)
+	if !ok {
+		return
+	}
+
+	record, exists, err := DBInstance.GetAttachmentRecordById(c.Param("attachmentId"))
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	if !exists || record.PasteId != paste.Id {
+		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
+			Code:        types.ErrAttachmentNotFound,
+			Explanation: types.ErrAttachmentNotFoundExp,
+		})
+		return
+	}
+
+	if err := DBInstance.DeleteRecord(record.Id, typesDB.AttachmentsTable); err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	retention.DeleteBlobs([]typesDB.AttachmentRecord{record})
+
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+	})
+}
+
+func attachmentName(name string) string {
+	name = filepath.Base(strings.ReplaceAll(name, "\\", "/"))
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, name)
+	if name == "" || name == "." || name == "/" {
+		return "attachment"
+	}
+	for len(name) > types.MaxPasteFileName {
+		_, size := utf8.DecodeLastRuneInString(name)
+		name = name[:len(name)-size]
+	}
+	return name
+}
+
+func attachmentFromRecord(record *typesDB.AttachmentRecord) types.Attachment {
+	return types.Attachment{
+		Id:          record.Id,
+		Name:        record.Name,
+		ContentType: record.ContentType,
+		Size:        record.Size,
+		Created:     record.Created,
+	}
+}
+
+func attachmentsFromRecords(records []typesDB.AttachmentRecord) []types.Attachment {
+	if len(records) == 0 {
+		return nil
+	}
+	attachments := make([]types.Attachment, 0, len(records))
+	for i := range records {
+		attachments = append(attachments, attachmentFromRecord(&records[i]))
+	}
+	return attachments
+}
@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"pasteGo/backend/api/rest/v1/types"
+	"pasteGo/backend/audit"
+	"pasteGo/backend/db"
+	"pasteGo/backend/db/typesDB"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultAuditEvents = 100
+	maxAuditEvents     = 1000
+)
+
+// GetAuditEvents отдаёт журнал аудита, новые события первыми.
+// Фильтры: actor, action, target, since, until (unix время или RFC 3339), limit
+func GetAuditEvents(c *gin.Context) {
+	filter, ok := auditFilter(c)
+	if !ok {
+		return
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultAuditEvents
+	}
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	records, err := DBInstance.GetAuditEventRecords(filter)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	events := make([]types.AuditEvent, 0, len(records))
+	for i := range records {
+		events = append(events, auditEventFromRecord(&records[i]))
+	}
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message:     events,
+	})
+}
+
+// ExportAuditEvents выгружает журнал в формате JSON Lines, старые события первыми.
+// Фильтры те же, что у GetAuditEvents, кроме limit: выгружается всё подходящее
+func ExportAuditEvents(c *gin.Context) {
+	filter, ok := auditFilter(c)
+	if !ok {
+		return
+	}
+	filter.Limit = 0
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+	recordAudit(c, DBInstance, audit.Event{
+		Actor:  userDB.Username,
+		Action: audit.ActionAuditExport,
+		Detail: c.Request.URL.RawQuery,
+	})
+
+	name := fmt.Sprintf("audit-%s.jsonl", time.Now().UTC().Format("20060102-150405"))
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name))
+	c.Header("Content-Type", types.ContentTypeJSONLines)
+	c.Status(http.StatusOK)
+
+	//Заголовки уже отправлены, ошибку посреди выгрузки можно только обрезать
+	encoder := json.NewEncoder(c.Writer)
+	err = DBInstance.EachAuditEventRecord(filter, func(record *typesDB.AuditEventRecord) error {
+		return encoder.Encode(auditEventFromRecord(record))
+	})
+	if err != nil {
+		c.Error(err)
+	}
+}
+
+// recordAudit пишет событие аудита с адресом и User-Agent клиента
+func recordAudit(c *gin.Context, DBInstance *db.DBInstance, event audit.Event) {
+	event.Ip = c.ClientIP()
+	event.UserAgent = c.Request.UserAgent()
+	audit.Record(DBInstance, event)
+}
+
+func auditFilter(c *gin.Context) (typesDB.AuditFilter, bool) {
+	filter := typesDB.AuditFilter{
+		Actor:  c.Query("actor"),
+		Action: c.Query("action"),
+		Target: c.Query("target"),
+	}
+
+	var err error
+	if filter.Since, err = auditTime(c.Query("since")); err != nil {
+		c.AbortWithError(http.StatusBadRequest, fmt.Errorf("since: %w", err))
+		return filter, false
+	}
+	if filter.Until, err = auditTime(c.Query("until")); err != nil {
+		c.AbortWithError(http.StatusBadRequest, fmt.Errorf("until: %w", err))
+		return filter, false
+	}
+	if value := c.Query("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			c.AbortWithError(http.StatusBadRequest, errors.New("limit must be a positive integer"))
+			return filter, false
+		}
+		filter.Limit = min(limit, maxAuditEvents)
+	}
+	return filter, true
+}
+
+// auditTime разбирает границу выборки: unix время или RFC 3339
+func auditTime(value string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	if unix, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return unix, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return 0, errors.New("expected unix time or RFC 3339")
+	}
+	return parsed.Unix(), nil
+}
+
+func auditEventFromRecord(record *typesDB.AuditEventRecord) types.AuditEvent {
+	return types.AuditEvent{
+		Id:        record.Id,
+		Time:      record.Time,
+		Actor:     record.Actor,
+		Action:    record.Action,
+		Target:    record.Target,
+		Ip:        record.Ip,
+		UserAgent: record.UserAgent,
+		Detail:    record.Detail,
+	}
+}
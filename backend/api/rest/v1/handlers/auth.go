@@ -1,15 +1,23 @@
 package handlers
 
 import (
+	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"log"
 	"net/http"
 	"strconv"
 
 	"pasteGo/backend/api/rest/v1/types"
+	"pasteGo/backend/audit"
+	"pasteGo/backend/auth"
+	"pasteGo/backend/config"
 	"pasteGo/backend/db"
 	"pasteGo/backend/db/typesDB"
+	"pasteGo/backend/ratelimit"
+	"pasteGo/backend/validation"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -22,6 +30,21 @@ func Register(c *gin.Context) {
 	if err := c.BindJSON(&user); err != nil {
 		return
 	}
+	if !config.LocalPasswords {
+		c.IndentedJSON(http.StatusForbidden, types.APIResponse{
+			Code:        types.ErrLocalPasswordsDisabled,
+			Explanation: types.ErrLocalPasswordsDisabledExp,
+		})
+		return
+	}
+
+	username, violations := validation.Username(user.Username)
+	violations = append(violations, validation.Password(user.Password, username)...)
+	email, emailViolations := validation.Email(user.Email)
+	if !checkViolations(c, append(violations, emailViolations...)) {
+		return
+	}
+	user.Username = username
 
 	DBInstance, err := db.GetDBInstance()
 	if err != nil {
@@ -31,11 +54,15 @@ func Register(c *gin.Context) {
 		})
 		return
 	}
+	if !emailAvailable(c, DBInstance, email, "") {
+		return
+	}
 	newUUID := uuid.New().String()
 	userRecord := typesDB.UserRecord{
 		Id:       newUUID,
 		Username: user.Username,
-		Password: ShaHashing(user.Password),
+		Password: auth.HashPassword(user.Password),
+		Email:    email,
 	}
 
 	created, err := DBInstance.AddUserRecord(&userRecord)
@@ -75,6 +102,11 @@ func Register(c *gin.Context) {
 	}
 
 	setCookies(c, newTokens, user.Username)
+	recordAudit(c, DBInstance, audit.Event{
+		Actor:  user.Username,
+		Action: audit.ActionRegister,
+		Target: audit.UserTarget(newUUID),
+	})
 
 	c.IndentedJSON(http.StatusCreated, types.APIResponse{
 		Code:        types.OperationSuccess,
@@ -87,17 +119,19 @@ func Login(c *gin.Context) {
 	if err := c.BindJSON(&user); err != nil {
 		return
 	}
+	//Имя хранится в форме NFKC, в ней же его нужно искать
+	user.Username = validation.NormalizeUsername(user.Username)
 
-	DBInstance, err := db.GetDBInstance()
-	if err != nil {
-		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
-			Code:        types.ErrServer,
-			Explanation: types.ErrServerExp,
+	chain := auth.GetChain()
+	if len(chain) == 0 {
+		c.IndentedJSON(http.StatusForbidden, types.APIResponse{
+			Code:        types.ErrLocalPasswordsDisabled,
+			Explanation: types.ErrLocalPasswordsDisabledExp,
 		})
 		return
 	}
 
-	userDB, exists, err := DBInstance.GetUserRecordByUsername(user.Username)
+	DBInstance, err := db.GetDBInstance()
 	if err != nil {
 		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
 			Code:        types.ErrServer,
@@ -105,41 +139,176 @@ func Login(c *gin.Context) {
 		})
 		return
 	}
-	if !exists {
+
+	ipKey, userKey := ratelimit.IPKey(c.ClientIP()), ratelimit.UserKey(user.Username)
+	if !allowAttempt(c, ipKey, userKey) {
+		return
+	}
+
+	result, err := chain.Authenticate(DBInstance, user.Username, user.Password)
+	switch {
+	case err == nil:
+	case errors.Is(err, auth.ErrUnknownUser):
+		failAttempt(c, DBInstance, user.Username, ipKey, userKey)
+		recordAudit(c, DBInstance, audit.Event{
+			Actor:  user.Username,
+			Action: audit.ActionLoginFailed,
+			Detail: "unknown user",
+		})
 		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
 			Code:        types.ErrUserNotFound,
 			Explanation: types.ErrUserNotFoundExp,
 		})
 		return
-	}
-
-	if userDB.Password != ShaHashing(user.Password) {
+	case errors.Is(err, auth.ErrWrongPassword):
+		failAttempt(c, DBInstance, user.Username, ipKey, userKey)
+		event := audit.Event{
+			Actor:  user.Username,
+			Action: audit.ActionLoginFailed,
+			Detail: "wrong password",
+		}
+		if result.User.Id != "" {
+			event.Target = audit.UserTarget(result.User.Id)
+		}
+		recordAudit(c, DBInstance, event)
 		c.IndentedJSON(http.StatusUnauthorized, types.APIResponse{
 			Code:        types.ErrWrongCredentials,
 			Explanation: types.ErrWrongCredentialsExp,
 		})
 		return
+	case errors.Is(err, auth.ErrNotAllowed):
+		recordAudit(c, DBInstance, audit.Event{
+			Actor:  user.Username,
+			Action: audit.ActionLoginFailed,
+			Detail: err.Error(),
+		})
+		c.IndentedJSON(http.StatusForbidden, types.APIResponse{
+			Code:        types.ErrLoginNotAllowed,
+			Explanation: types.ErrLoginNotAllowedExp,
+		})
+		return
+	case errors.Is(err, auth.ErrUsernameTaken):
+		recordAudit(c, DBInstance, audit.Event{
+			Actor:  user.Username,
+			Action: audit.ActionLoginFailed,
+			Detail: err.Error(),
+		})
+		c.IndentedJSON(http.StatusConflict, types.APIResponse{
+			Code:        types.ErrExistUser,
+			Explanation: types.ErrExistUserExp,
+		})
+		return
+	default:
+		log.Printf("login %s: %s", user.Username, err)
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
 	}
 
+	userDB := result.User
+	if result.Created {
+		recordAudit(c, DBInstance, audit.Event{
+			Actor:  userDB.Username,
+			Action: audit.ActionRegister,
+			Target: audit.UserTarget(userDB.Id),
+			Detail: result.Backend,
+		})
+	}
+	if result.Admin != nil && !syncAdmin(c, DBInstance, &userDB, *result.Admin, result.Backend) {
+		return
+	}
+	if result.Groups != nil && !syncGroups(c, DBInstance, userDB, result.Groups, result.Backend) {
+		return
+	}
+
+	//С включённой 2FA cookies выдаёт LoginTwoFactor после проверки кода
+	if typesDB.IntToBool(userDB.TotpEnabled) {
+		respondLoginChallenge(c, userDB)
+		return
+	}
+
+	if !startSession(c, DBInstance, userDB) {
+		return
+	}
+	resetAttempts(userKey)
+	recordAudit(c, DBInstance, audit.Event{
+		Actor:  userDB.Username,
+		Action: audit.ActionLogin,
+		Target: audit.UserTarget(userDB.Id),
+		Detail: result.Backend,
+	})
+
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+	})
+}
+
+// syncAdmin выдаёт или снимает права администратора по группам внешнего бэкенда actor.
+// При ошибке ответ уже записан и возвращается false
+func syncAdmin(c *gin.Context, DBInstance *db.DBInstance, userDB *typesDB.UserRecord, admin bool, actor string) bool {
+	if admin == typesDB.IntToBool(userDB.Admin) {
+		return true
+	}
+	if err := DBInstance.SetUserAdmin(userDB.Id, admin); err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return false
+	}
+	userDB.Admin = typesDB.BoolToInt(admin)
+
+	action := audit.ActionAdminRevoke
+	if admin {
+		action = audit.ActionAdminGrant
+	}
+	recordAudit(c, DBInstance, audit.Event{
+		Actor:  actor,
+		Action: action,
+		Target: audit.UserTarget(userDB.Id),
+		Detail: userDB.Username,
+	})
+	return true
+}
+
+// syncGroups запоминает группы пользователя во внешнем бэкенде source: по ним
+// действуют доступы к вставкам, выданные группам. При ошибке ответ уже записан
+func syncGroups(c *gin.Context, DBInstance *db.DBInstance, userDB typesDB.UserRecord, groups []string, source string) bool {
+	if err := DBInstance.SetUserGroups(userDB.Id, source, groups); err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return false
+	}
+	return true
+}
+
+// startSession заменяет refresh-токены пользователя новой парой и ставит cookies.
+// При ошибке ответ уже записан и возвращается false
+func startSession(c *gin.Context, DBInstance *db.DBInstance, userDB typesDB.UserRecord) bool {
 	oldTokens, err := DBInstance.GetTokenByUserId(userDB.Id)
 	if err != nil {
 		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
 			Code:        types.ErrServer,
 			Explanation: types.ErrServerExp,
 		})
-		return
+		return false
 	}
 	for i := range *oldTokens {
 		DBInstance.DeleteToken((*oldTokens)[i].RefreshToken)
 	}
 
-	newTokens, err := GenerateTokens(user.Username)
+	newTokens, err := GenerateTokens(userDB.Username)
 	if err != nil {
 		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
 			Code:        types.ErrServer,
 			Explanation: types.ErrServerExp,
 		})
-		return
+		return false
 	}
 	created, err := DBInstance.AddToken(&typesDB.TokenRecord{
 		UserId:       userDB.Id,
@@ -150,15 +319,11 @@ func Login(c *gin.Context) {
 			Code:        types.ErrServer,
 			Explanation: types.ErrServerExp,
 		})
-		return
+		return false
 	}
 
-	setCookies(c, newTokens, user.Username)
-
-	c.IndentedJSON(http.StatusOK, types.APIResponse{
-		Code:        types.OperationSuccess,
-		Explanation: types.OperationSuccessExp,
-	})
+	setCookies(c, newTokens, userDB.Username)
+	return true
 }
 
 func Logout(c *gin.Context) {
@@ -336,9 +501,17 @@ func generatejwt(claims jwt.RegisteredClaims) (string, error) {
 	return token.SignedString(types.SecretKey)
 }
 
+// derivedKey - отдельный ключ подписи для токенов, которые не должны проходить как access-токен
+func derivedKey(purpose string) []byte {
+	mac := hmac.New(sha256.New, types.SecretKey)
+	mac.Write([]byte(purpose))
+	return mac.Sum(nil)
+}
+
+// ShaHashing - хеш случайных токенов и кодов восстановления, по которому их ищут в базе.
+// Пароли хешируются auth.HashPassword
 func ShaHashing(input string) string {
-	plainText := []byte(input)
-	sha256Hash := sha256.Sum256(plainText)
+	sha256Hash := sha256.Sum256([]byte(input))
 	return hex.EncodeToString(sha256Hash[:])
 }
 
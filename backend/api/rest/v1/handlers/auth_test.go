@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"pasteGo/backend/api/rest/v1/types"
+	"pasteGo/backend/auth"
+	"pasteGo/backend/auth/ldaptest"
+	"pasteGo/backend/config"
+	"pasteGo/backend/db/typesDB"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	testDevelopers = "cn=developers,ou=groups,dc=example,dc=org"
+	testOperators  = "cn=operators,ou=groups,dc=example,dc=org"
+)
+
+var (
+	directory     *ldaptest.Server
+	directoryOnce sync.Once
+)
+
+// testDirectory запускает каталог один раз: цепочка auth.GetChain собирается на весь процесс
+func testDirectory(t *testing.T) *ldaptest.Server {
+	t.Helper()
+	directoryOnce.Do(func() {
+		server, err := ldaptest.NewServer("", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		directory = server
+
+		config.AuthBackends = []string{"local", "ldap"}
+		config.LocalPasswords = true
+		config.LDAP = config.LDAPConfig{
+			URL:               server.URL,
+			BaseDN:            "dc=example,dc=org",
+			UserFilter:        "(uid=%s)",
+			UsernameAttribute: "uid",
+			GroupAttribute:    "memberOf",
+			Timeout:           5 * time.Second,
+		}
+	})
+	if chain := auth.GetChain(); len(chain) != 2 {
+		t.Fatalf("chain = %v", chain)
+	}
+	return directory
+}
+
+func TestLoginSyncsLDAPGroups(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	types.SecretKey = []byte("ldap-test-secret")
+	DBInstance := openTestDB(t)
+
+	alice := ldaptest.Entry{
+		DN:       "uid=alice,ou=people,dc=example,dc=org",
+		Password: "alice-secret",
+		Attributes: map[string][]string{
+			"uid":      {"alice"},
+			"memberOf": {testDevelopers, testOperators},
+		},
+	}
+	server := testDirectory(t)
+	server.Update(alice)
+
+	//Вставки владельца выданы группам каталога
+	if _, err := DBInstance.AddUserRecord(&typesDB.UserRecord{Id: "owner-id", Username: "owner", Password: auth.HashPassword("owner-secret")}); err != nil {
+		t.Fatal(err)
+	}
+	for _, share := range []struct{ pasteId, group string }{{"developers-paste", testDevelopers}, {"operators-paste", testOperators}} {
+		if _, err := DBInstance.AddPasteRecord(&typesDB.PasteRecord{Id: share.pasteId, UserId: "owner-id", Text: "x", Created: time.Now().Unix(), Lifetime: -1}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := DBInstance.SetShareRecord(&typesDB.ShareRecord{Id: share.pasteId + "-share", PasteId: share.pasteId, GroupName: share.group, Permission: typesDB.PermissionRead}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	router := gin.New()
+	router.POST("/login", Login)
+	login := func(username string, password string) {
+		t.Helper()
+		w := httptest.NewRecorder()
+		body := `{"username":"` + username + `","password":"` + password + `"}`
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(body)))
+		if w.Code != http.StatusOK {
+			t.Fatalf("login %s: status %d: %s", username, w.Code, w.Body)
+		}
+	}
+	permissions := func(userId string) map[string]string {
+		t.Helper()
+		result := map[string]string{}
+		for _, pasteId := range []string{"developers-paste", "operators-paste"} {
+			permission, err := DBInstance.GetPastePermission(pasteId, userId)
+			if err != nil {
+				t.Fatal(err)
+			}
+			result[pasteId] = permission
+		}
+		return result
+	}
+
+	login("alice", "alice-secret")
+	userDB, exists, err := DBInstance.GetUserRecordByIdentity(auth.ProviderLDAP, "alice")
+	if err != nil || !exists {
+		t.Fatalf("alice is not provisioned: %v", err)
+	}
+	if got := permissions(userDB.Id); got["developers-paste"] != typesDB.PermissionRead || got["operators-paste"] != typesDB.PermissionRead {
+		t.Errorf("permissions after the first login = %v", got)
+	}
+
+	//Каждый вход заменяет группы: снятое в каталоге членство отзывает доступ
+	alice.Attributes["memberOf"] = []string{testDevelopers}
+	server.Update(alice)
+	login("alice", "alice-secret")
+	if got := permissions(userDB.Id); got["developers-paste"] != typesDB.PermissionRead || got["operators-paste"] != "" {
+		t.Errorf("permissions after leaving operators = %v", got)
+	}
+
+	alice.Attributes["memberOf"] = nil
+	server.Update(alice)
+	login("alice", "alice-secret")
+	if got := permissions(userDB.Id); got["developers-paste"] != "" || got["operators-paste"] != "" {
+		t.Errorf("permissions after leaving every group = %v", got)
+	}
+
+	//Вход локального пользователя группы не трогает
+	login("owner", "owner-secret")
+	if got := permissions("owner-id"); got["developers-paste"] != "" {
+		t.Errorf("owner permissions = %v", got)
+	}
+}
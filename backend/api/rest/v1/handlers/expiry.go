@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"net/http"
+	"pasteGo/backend/api/rest/v1/types"
+	"pasteGo/backend/db"
+	"pasteGo/backend/expiry"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetPasteExpiry меняет срок жизни вставки, не трогая её содержимое и версию
+func SetPasteExpiry(c *gin.Context) {
+	change := types.ExpiryChange{}
+	if err := c.BindJSON(&change); err != nil {
+		return
+	}
+	if (change.Lifetime == "") == (change.Extend == "") {
+		c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+			Code:        types.ErrPasteLifetime,
+			Explanation: types.ErrPasteLifetimeExp,
+		})
+		return
+	}
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+	paste, ok := getOwnedPaste(c, DBInstance, c.Param("id"), userDB)
+	if !ok {
+		return
+	}
+
+	timeNow := time.Now()
+	var expires int64
+	if change.Extend != "" {
+		expires, err = expiry.Extend(paste.Lifetime, change.Extend, timeNow)
+		if err != nil {
+			respondLifetimeError(c, err)
+			return
+		}
+	} else if expires, ok = pasteExpires(c, change.Lifetime, timeNow); !ok {
+		return
+	}
+
+	if err := DBInstance.SetPasteLifetime(paste.Id, expires); err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message: types.PasteExpiry{
+			Id:      paste.Id,
+			ExpTime: expires,
+		},
+	})
+}
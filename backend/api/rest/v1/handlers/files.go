@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"net/http"
+	"pasteGo/backend/api/rest/v1/types"
+	"pasteGo/backend/db"
+	"pasteGo/backend/db/typesDB"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func GetPasteRaw(c *gin.Context) {
+	paste, _, ok := getAccessiblePaste(c, c.Param("id"), c.GetHeader(types.HeaderPastePassword))
+	if !ok {
+		return
+	}
+
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Data(http.StatusOK, "text/plain; charset=utf-8", []byte(paste.Text))
+}
+
+func GetPasteFileRaw(c *gin.Context) {
+	paste, _, ok := getAccessiblePaste(c, c.Param("id"), c.GetHeader(types.HeaderPastePassword))
+	if !ok {
+		return
+	}
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	file, exists, err := DBInstance.GetPasteFile(paste.Id, c.Param("name"))
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	if !exists {
+		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
+			Code:        types.ErrPasteFileNotFound,
+			Explanation: types.ErrPasteFileNotFoundExp,
+		})
+		return
+	}
+
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Data(http.StatusOK, "text/plain; charset=utf-8", []byte(file.Text))
+}
+
+// GetPasteZip отдаёт все файлы вставки одним архивом. Обычная вставка без
+// файлов попадает в архив как paste.txt
+func GetPasteZip(c *gin.Context) {
+	paste, _, ok := getAccessiblePaste(c, c.Param("id"), c.GetHeader(types.HeaderPastePassword))
+	if !ok {
+		return
+	}
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	files, err := DBInstance.GetPasteFiles(paste.Id)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	if len(files) == 0 {
+		files = append(files, typesDB.PasteFileRecord{Name: "paste.txt", Text: paste.Text})
+	}
+
+	modified := time.Unix(paste.Created, 0)
+	if paste.Updated > 0 {
+		modified = time.Unix(paste.Updated, 0)
+	}
+
+	buffer := bytes.Buffer{}
+	archive := zip.NewWriter(&buffer)
+	for i := range files {
+		writer, err := archive.CreateHeader(&zip.FileHeader{
+			Name:     files[i].Name,
+			Method:   zip.Deflate,
+			Modified: modified,
+		})
+		if err == nil {
+			_, err = writer.Write([]byte(files[i].Text))
+		}
+		if err != nil {
+			c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+				Code:        types.ErrServer,
+				Explanation: types.ErrServerExp,
+			})
+			return
+		}
+	}
+	if err := archive.Close(); err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", paste.Id+".zip"))
+	c.Data(http.StatusOK, "application/zip", buffer.Bytes())
+}
+
+func validatePasteFiles(files []types.PasteFile) bool {
+	if len(files) > types.MaxPasteFiles {
+		return false
+	}
+	names := make(map[string]bool, len(files))
+	for i := range files {
+		name := files[i].Name
+		if name == "" || name == "." || name == ".." || len(name) > types.MaxPasteFileName {
+			return false
+		}
+		if strings.ContainsAny(name, "/\\\x00") || names[name] {
+			return false
+		}
+		names[name] = true
+	}
+	return true
+}
+
+func pasteFileRecords(files []types.PasteFile) []typesDB.PasteFileRecord {
+	records := make([]typesDB.PasteFileRecord, 0, len(files))
+	for i := range files {
+		records = append(records, typesDB.PasteFileRecord{
+			Position: i,
+			Name:     files[i].Name,
+			Language: files[i].Language,
+			Text:     files[i].Text,
+		})
+	}
+	return records
+}
+
+func pasteFilesFromRecords(records []typesDB.PasteFileRecord) []types.PasteFile {
+	if len(records) == 0 {
+		return nil
+	}
+	files := make([]types.PasteFile, 0, len(records))
+	for i := range records {
+		files = append(files, types.PasteFile{
+			Name:     records[i].Name,
+			Language: records[i].Language,
+			Text:     records[i].Text,
+		})
+	}
+	return files
+}
@@ -0,0 +1,225 @@
+package handlers
+
+import (
+	"net/http"
+	"pasteGo/backend/api/rest/v1/types"
+	"pasteGo/backend/db"
+	"pasteGo/backend/db/typesDB"
+	"pasteGo/backend/expiry"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func ForkPaste(c *gin.Context) {
+	pastePsw := types.PastePassword{}
+	pasteId := c.Param("id")
+	if c.Request.ContentLength != 0 {
+		if err := c.BindJSON(&pastePsw); err != nil {
+			return
+		}
+	}
+	if pastePsw.Password == "" {
+		pastePsw.Password = c.GetHeader(types.HeaderPastePassword)
+	}
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+
+	source, _, ok := getCopyablePaste(c, pasteId, pastePsw.Password)
+	if !ok {
+		return
+	}
+
+	files, err := DBInstance.GetPasteFiles(source.Id)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	if !checkQuota(c, DBInstance, userDB.Id, 1, int64(len(source.Text))+pasteFileRecordsSize(files)) {
+		return
+	}
+
+	//Форк наследует видимость и пароль, чтобы не открывать текст шире оригинала.
+	//Вложения не копируются
+	pasteRecord := typesDB.PasteRecord{
+		Id:                 uuid.New().String(),
+		UserId:             userDB.Id,
+		Title:              source.Title,
+		Language:           source.Language,
+		LanguageConfidence: source.LanguageConfidence,
+		ContentType:        source.ContentType,
+		Text:               source.Text,
+		Created:            time.Now().Unix(),
+		Updated:            -1,
+		Lifetime:           expiry.Default(time.Now()),
+		Password:           source.Password,
+		Public:             source.Public,
+		ForkedFrom:         source.Id,
+	}
+
+	created, err := DBInstance.AddPasteRecord(&pasteRecord)
+	if err != nil || !created {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	if len(files) > 0 {
+		for i := range files {
+			files[i].PasteId = pasteRecord.Id
+		}
+		if err := DBInstance.SetPasteFiles(pasteRecord.Id, files); err != nil {
+			c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+				Code:        types.ErrServer,
+				Explanation: types.ErrServerExp,
+			})
+			return
+		}
+	}
+
+	c.Header(types.HeaderETag, pasteETag(pasteRecord.Version))
+	c.IndentedJSON(http.StatusCreated, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message: types.Paste{
+			Id:                 pasteRecord.Id,
+			Author:             userDB.Username,
+			Created:            pasteRecord.Created,
+			Updated:            pasteRecord.Updated,
+			ExpTime:            pasteRecord.Lifetime,
+			Title:              pasteRecord.Title,
+			Language:           pasteRecord.Language,
+			LanguageConfidence: pasteRecord.LanguageConfidence,
+			ContentType:        pasteRecord.ContentType,
+			Text:               pasteRecord.Text,
+			Files:              pasteFilesFromRecords(files),
+			ForkedFrom:         pasteRecord.ForkedFrom,
+			Password:           "",
+			HasPassword:        pasteRecord.Password != "",
+			Public:             typesDB.IntToBool(pasteRecord.Public),
+			Version:            pasteRecord.Version,
+		},
+	})
+}
+
+func GetPasteForks(c *gin.Context) {
+	pasteId := c.Param("id")
+
+	paste, userDB, ok := getAccessiblePaste(c, pasteId, c.GetHeader(types.HeaderPastePassword))
+	if !ok {
+		return
+	}
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	forks, err := visibleForks(c, DBInstance, paste)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	root := types.ForkNode{
+		Id:          paste.Id,
+		Author:      userDB.Username,
+		Title:       paste.Title,
+		Created:     paste.Created,
+		ExpTime:     paste.Lifetime,
+		Public:      typesDB.IntToBool(paste.Public),
+		HasPassword: paste.Password != "",
+		Forks:       forks,
+	}
+
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message:     root,
+	})
+}
+
+// visibleForks - дерево форков вставки в том виде, в каком его видит текущий пользователь.
+// Непубличные форки видны тем же, кто может их прочитать. Ошибка базы скрывает узел
+func visibleForks(c *gin.Context, DBInstance *db.DBInstance, paste typesDB.PasteRecord) ([]types.ForkNode, error) {
+	records, err := DBInstance.GetForkRecords(paste.Id)
+	if err != nil {
+		return nil, err
+	}
+	viewer, authenticated := getViewer(c, DBInstance)
+	return buildForkTree(paste.Id, records, func(record typesDB.ForkRecord) bool {
+		if typesDB.IntToBool(record.Public) {
+			return true
+		}
+		if !authenticated {
+			return false
+		}
+		permission, err := pastePermission(DBInstance, typesDB.PasteRecord{Id: record.Id, UserId: record.UserId, TeamId: record.TeamId}, viewer)
+		return err == nil && permission != ""
+	}), nil
+}
+
+// buildForkTree собирает потомков rootId. Скрытые узлы (истёкшие и те, для которых visible
+// вернула false) пропускаются, их форки поднимаются к ближайшему видимому предку
+func buildForkTree(rootId string, records []typesDB.ForkRecord, visible func(record typesDB.ForkRecord) bool) []types.ForkNode {
+	now := time.Now().Unix()
+	//Записи идут по возрастанию глубины, поэтому родитель всегда обработан раньше
+	shownAs := map[string]string{rootId: rootId}
+	children := make(map[string][]typesDB.ForkRecord)
+	for i := range records {
+		parent, ok := shownAs[records[i].ForkedFrom]
+		if !ok {
+			continue
+		}
+		expired := records[i].Lifetime > 0 && records[i].Lifetime < now
+		if expired || !visible(records[i]) {
+			shownAs[records[i].Id] = parent
+			continue
+		}
+		shownAs[records[i].Id] = records[i].Id
+		children[parent] = append(children[parent], records[i])
+	}
+
+	var build func(id string) []types.ForkNode
+	build = func(id string) []types.ForkNode {
+		nodes := make([]types.ForkNode, 0, len(children[id]))
+		for _, record := range children[id] {
+			nodes = append(nodes, types.ForkNode{
+				Id:          record.Id,
+				Author:      record.Username,
+				Title:       record.Title,
+				Created:     record.Created,
+				ExpTime:     record.Lifetime,
+				Public:      typesDB.IntToBool(record.Public),
+				HasPassword: typesDB.IntToBool(record.HasPassword),
+				Forks:       build(record.Id),
+			})
+		}
+		return nodes
+	}
+	return build(rootId)
+}
@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"os"
+	"pasteGo/backend/config"
+	"pasteGo/backend/db"
+	"testing"
+)
+
+// openTestDB открывает пустую базу во временном каталоге: путь к базе относительный
+func openTestDB(t *testing.T) *db.DBInstance {
+	t.Helper()
+	//Ограничитель попыток общий на процесс, все тесты ходят с одного адреса,
+	//а неудачи по адресу не сбрасываются и копятся между прогонами
+	config.RateLimitBurst = 1000
+	config.LockoutThreshold = 1000
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		db.CloseDB()
+		os.Chdir(wd)
+	})
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := DBInstance.Init(); err != nil {
+		t.Fatal(err)
+	}
+	return DBInstance
+}
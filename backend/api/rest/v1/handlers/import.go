@@ -0,0 +1,192 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"pasteGo/backend/api/rest/v1/types"
+	"pasteGo/backend/config"
+	"pasteGo/backend/db"
+	"pasteGo/backend/db/typesDB"
+	"pasteGo/backend/importer"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func ImportPastes(c *gin.Context) {
+	format := c.Param("format")
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, importer.MaxArchiveSize)
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+			Code:        types.ErrImportFile,
+			Explanation: types.ErrImportFileExp,
+		})
+		return
+	}
+	public, _ := strconv.ParseBool(c.PostForm("public"))
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	rawClaims, exists := c.Get("userClaims")
+	if !exists {
+		c.IndentedJSON(http.StatusUnauthorized, types.APIResponse{
+			Code:        types.ErrGetCookies,
+			Explanation: types.ErrGetCookiesExp,
+		})
+		DumpCookies(c)
+		return
+	}
+
+	claims, ok := rawClaims.(*jwt.RegisteredClaims)
+	if !ok {
+		c.IndentedJSON(http.StatusUnauthorized, types.APIResponse{
+			Code:        types.ErrJWTProcessing,
+			Explanation: types.ErrJWTProcessingExp,
+		})
+		DumpCookies(c)
+		return
+	}
+
+	userDB, exists, err := DBInstance.GetUserRecordByUsername(claims.Subject)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	if !exists {
+		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
+			Code:        types.ErrUserNotFound,
+			Explanation: types.ErrUserNotFoundExp,
+		})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+			Code:        types.ErrImportFile,
+			Explanation: types.ErrImportFileExp,
+		})
+		return
+	}
+	defer file.Close()
+	data, err := io.ReadAll(file)
+	if err != nil {
+		c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+			Code:        types.ErrImportFile,
+			Explanation: types.ErrImportFileExp,
+		})
+		return
+	}
+
+	fsys, err := importer.OpenArchive(fileHeader.Filename, data, time.Now())
+	if err != nil {
+		c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+			Code:        types.ErrImportFile,
+			Explanation: types.ErrImportFileExp,
+		})
+		return
+	}
+	entries, err := importer.Parse(format, fsys, importer.Options{Public: public})
+	if errors.Is(err, importer.ErrUnknownFormat) {
+		c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+			Code:        types.ErrImportFormat,
+			Explanation: types.ErrImportFormatExp,
+			Message:     gin.H{"formats": importer.Formats()},
+		})
+		return
+	}
+	if err != nil {
+		c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+			Code:        types.ErrImportFile,
+			Explanation: types.ErrImportFileExp,
+		})
+		return
+	}
+
+	//MAX_PASTE_SIZE действует на каждую вставку: BodyLimitMiddleware ограничивает только тело запроса
+	for i := range entries {
+		if config.MaxPasteSize >= 0 && entries[i].HasContent() && entries[i].Size() > config.MaxPasteSize {
+			c.IndentedJSON(http.StatusRequestEntityTooLarge, types.APIResponse{
+				Code:        types.ErrPasteTooLarge,
+				Explanation: types.ErrPasteTooLargeExp,
+				Message:     gin.H{"title": entries[i].Title},
+			})
+			return
+		}
+	}
+
+	pastes, size := importer.Size(entries)
+	if !checkQuota(c, DBInstance, userDB.Id, pastes, size) {
+		return
+	}
+	//Каждая вставка проверяется как в CreatePaste. В режиме reject не сохраняется ни одна
+	warnings := make([][]types.SecretWarning, 0, pastes)
+	for i := range entries {
+		if !entries[i].HasContent() {
+			continue
+		}
+		paste := types.Paste{Text: entries[i].Text, Public: entries[i].Public}
+		files := make([]types.PasteFile, 0, len(entries[i].Files))
+		for _, file := range entries[i].Files {
+			files = append(files, types.PasteFile{Name: file.Name, Text: file.Text})
+		}
+		found, ok := scanSecrets(c, &paste, files)
+		if !ok {
+			return
+		}
+		entries[i].Public = paste.Public
+		warnings = append(warnings, found)
+	}
+
+	records, err := importer.Save(DBInstance, userDB.Id, entries)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	imported := make([]types.Paste, 0, len(records))
+	for i := range records {
+		imported = append(imported, types.Paste{
+			Id:                 records[i].Id,
+			Author:             claims.Subject,
+			Created:            records[i].Created,
+			Updated:            records[i].Updated,
+			ExpTime:            records[i].Lifetime,
+			Title:              records[i].Title,
+			Language:           records[i].Language,
+			LanguageConfidence: records[i].LanguageConfidence,
+			ContentType:        records[i].ContentType,
+			Warnings:           warnings[i],
+			HasPassword:        false,
+			Public:             typesDB.IntToBool(records[i].Public),
+			Version:            records[i].Version,
+		})
+	}
+
+	c.IndentedJSON(http.StatusCreated, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message: types.ImportResult{
+			Imported: len(imported),
+			Pastes:   imported,
+		},
+	})
+}
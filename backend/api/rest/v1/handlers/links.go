@@ -0,0 +1,271 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"pasteGo/backend/api/rest/v1/types"
+	"pasteGo/backend/audit"
+	"pasteGo/backend/db"
+	"pasteGo/backend/db/typesDB"
+	"pasteGo/backend/expiry"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+const (
+	shareLinkAudience        = "share-link"
+	defaultShareLinkLifetime = "day"
+)
+
+// shareLinkClaims - содержимое токена ссылки: jti - id ссылки, sub - id вставки
+type shareLinkClaims struct {
+	MaxViews int64 `json:"max_views,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// GetShareLinks возвращает действующие ссылки на вставку вместе с токенами, список видит только автор
+func GetShareLinks(c *gin.Context) {
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+	paste, ok := getOwnedPaste(c, DBInstance, c.Param("id"), userDB)
+	if !ok {
+		return
+	}
+
+	records, err := DBInstance.GetShareLinkRecords(paste.Id, time.Now().Unix())
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	links := make([]types.ShareLink, 0, len(records))
+	for i := range records {
+		link, err := shareLinkFromRecord(&records[i])
+		if err != nil {
+			c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+				Code:        types.ErrServer,
+				Explanation: types.ErrServerExp,
+			})
+			return
+		}
+		links = append(links, link)
+	}
+
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message:     types.ShareLinkList{Links: links},
+	})
+}
+
+// CreateShareLink создаёт подписанную ссылку, по которой вставку читают без входа.
+// Срок обязателен, по умолчанию - сутки
+func CreateShareLink(c *gin.Context) {
+	link := types.ShareLink{}
+	if err := c.BindJSON(&link); err != nil {
+		return
+	}
+	if link.Lifetime == "" {
+		link.Lifetime = defaultShareLinkLifetime
+	}
+	timeNow := time.Now()
+	expires, ok := pasteExpires(c, link.Lifetime, timeNow)
+	if !ok {
+		return
+	}
+	if expires == expiry.Never || link.MaxViews < 0 {
+		c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+			Code:        types.ErrShareLink,
+			Explanation: types.ErrShareLinkExp,
+		})
+		return
+	}
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+	paste, ok := getOwnedPaste(c, DBInstance, c.Param("id"), userDB)
+	if !ok {
+		return
+	}
+
+	record := typesDB.ShareLinkRecord{
+		Id:       uuid.NewString(),
+		PasteId:  paste.Id,
+		Expires:  expires,
+		MaxViews: link.MaxViews,
+		Created:  timeNow.Unix(),
+	}
+	response, err := shareLinkFromRecord(&record)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	if err := DBInstance.AddShareLinkRecord(&record, timeNow.Unix()); err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	views := "unlimited"
+	if record.MaxViews > 0 {
+		views = strconv.FormatInt(record.MaxViews, 10)
+	}
+	recordAudit(c, DBInstance, audit.Event{
+		Actor:  userDB.Username,
+		Action: audit.ActionPasteLinkCreate,
+		Target: audit.PasteTarget(paste.Id),
+		Detail: fmt.Sprintf("link %s until %s, views %s", record.Id, time.Unix(record.Expires, 0).UTC().Format(time.RFC3339), views),
+	})
+
+	c.IndentedJSON(http.StatusCreated, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message:     response,
+	})
+}
+
+// RevokeShareLink отзывает ссылку: её токен больше не принимается
+func RevokeShareLink(c *gin.Context) {
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+	paste, ok := getOwnedPaste(c, DBInstance, c.Param("id"), userDB)
+	if !ok {
+		return
+	}
+
+	deleted, err := DBInstance.DeleteShareLinkRecord(paste.Id, c.Param("linkId"))
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	if !deleted {
+		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
+			Code:        types.ErrShareLinkNotFound,
+			Explanation: types.ErrShareLinkNotFoundExp,
+		})
+		return
+	}
+	recordAudit(c, DBInstance, audit.Event{
+		Actor:  userDB.Username,
+		Action: audit.ActionPasteLinkRevoke,
+		Target: audit.PasteTarget(paste.Id),
+		Detail: "link " + c.Param("linkId"),
+	})
+
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+	})
+}
+
+// checkShareLink проверяет подпись и срок токена и то, что ссылка на вставку paste
+// не отозвана и у неё остались просмотры. Возвращает id ссылки, пустая строка - ссылка не подходит
+func checkShareLink(DBInstance *db.DBInstance, paste typesDB.PasteRecord, token string) (string, error) {
+	claims, err := parseShareLink(token)
+	if err != nil || claims.Subject != paste.Id {
+		return "", nil
+	}
+	record, exists, err := DBInstance.GetShareLinkRecord(claims.ID)
+	if err != nil || !exists {
+		return "", err
+	}
+	if record.PasteId != paste.Id || record.Expires <= time.Now().Unix() ||
+		(record.MaxViews > 0 && record.Views >= record.MaxViews) {
+		return "", nil
+	}
+	return record.Id, nil
+}
+
+// signShareLink подписывает ссылку отдельным ключом. Подпись зависит только от полей
+// записи, поэтому список ссылок отдаёт те же токены, что были выданы при создании
+func signShareLink(record *typesDB.ShareLinkRecord) (string, error) {
+	claims := shareLinkClaims{
+		MaxViews: record.MaxViews,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        record.Id,
+			Subject:   record.PasteId,
+			Audience:  jwt.ClaimStrings{shareLinkAudience},
+			IssuedAt:  jwt.NewNumericDate(time.Unix(record.Created, 0)),
+			ExpiresAt: jwt.NewNumericDate(time.Unix(record.Expires, 0)),
+		},
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(derivedKey("share-link"))
+}
+
+func parseShareLink(token string) (*shareLinkClaims, error) {
+	parsed, err := jwt.ParseWithClaims(token, &shareLinkClaims{}, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+		return derivedKey("share-link"), nil
+	}, jwt.WithAudience(shareLinkAudience), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse share link: %w", err)
+	}
+	claims, ok := parsed.Claims.(*shareLinkClaims)
+	if !ok || !parsed.Valid || claims.ID == "" {
+		return nil, fmt.Errorf("share link is invalid")
+	}
+	return claims, nil
+}
+
+func shareLinkFromRecord(record *typesDB.ShareLinkRecord) (types.ShareLink, error) {
+	token, err := signShareLink(record)
+	if err != nil {
+		return types.ShareLink{}, err
+	}
+	return types.ShareLink{
+		Id:       record.Id,
+		Token:    token,
+		Expires:  record.Expires,
+		MaxViews: record.MaxViews,
+		Views:    record.Views,
+		Created:  record.Created,
+	}, nil
+}
@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"pasteGo/backend/api/rest/v1/types"
+	"pasteGo/backend/db/typesDB"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestForkRejectsShareLink(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	types.SecretKey = []byte("share-link-test-secret")
+	DBInstance := openTestDB(t)
+	now := time.Now().Unix()
+
+	for _, username := range []string{"owner", "bob"} {
+		if _, err := DBInstance.AddUserRecord(&typesDB.UserRecord{Id: username + "-id", Username: username}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := DBInstance.AddPasteRecord(&typesDB.PasteRecord{Id: "private-paste", UserId: "owner-id", Text: "secret text", Created: now, Lifetime: -1}); err != nil {
+		t.Fatal(err)
+	}
+	link := typesDB.ShareLinkRecord{Id: "link-id", PasteId: "private-paste", Expires: now + 3600, Created: now}
+	if err := DBInstance.AddShareLinkRecord(&link, now); err != nil {
+		t.Fatal(err)
+	}
+	token, err := signShareLink(&link)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//Access токен проверяется так же, как в middlewares.JwtMiddleware
+	router := gin.New()
+	authorized := func(c *gin.Context) {
+		accessToken, err := c.Cookie(types.CookieAccessToken)
+		if err != nil {
+			return
+		}
+		if claims, err := ParseClaims(accessToken); err == nil {
+			c.Set("userClaims", claims)
+		}
+	}
+	router.GET("/pastes/:id", ReadPaste)
+	router.GET("/pastes/:id/forks", GetPasteForks)
+	router.POST("/pastes/:id/forks", authorized, ForkPaste)
+
+	tokens, err := GenerateTokens("bob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	request := func(method string, path string, link string) *httptest.ResponseRecorder {
+		t.Helper()
+		if link != "" {
+			path += "?" + url.Values{types.QueryShareLink: {link}}.Encode()
+		}
+		r := httptest.NewRequest(method, path, nil)
+		r.AddCookie(&http.Cookie{Name: types.CookieAccessToken, Value: tokens.AccessToken})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+		return w
+	}
+
+	//Ссылка даёт читать вставку и её дерево форков
+	if w := request(http.MethodGet, "/pastes/private-paste", token); w.Code != http.StatusOK {
+		t.Fatalf("read by link: status %d: %s", w.Code, w.Body)
+	}
+	if w := request(http.MethodGet, "/pastes/private-paste/forks", token); w.Code != http.StatusOK {
+		t.Fatalf("fork tree by link: status %d: %s", w.Code, w.Body)
+	}
+
+	//Но не копировать: форк пережил бы срок и отзыв ссылки
+	w := request(http.MethodPost, "/pastes/private-paste/forks", token)
+	if w.Code != http.StatusForbidden || responseCode(t, w) != types.ErrPasteNotShared {
+		t.Errorf("fork by link: status %d: %s", w.Code, w.Body)
+	}
+	pastes, err := DBInstance.GetPasteRecordsByUserId("bob-id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*pastes) != 0 {
+		t.Errorf("bob owns %d pastes after a rejected fork", len(*pastes))
+	}
+	if record, _, err := DBInstance.GetShareLinkRecord("link-id"); err != nil || record.Views != 2 {
+		t.Errorf("link views = %d, %v, want 2: a rejected fork is not a view", record.Views, err)
+	}
+
+	//Доступ, выданный автором, позволяет форк и без ссылки
+	if _, err := DBInstance.SetShareRecord(&typesDB.ShareRecord{Id: "share-id", PasteId: "private-paste", UserId: "bob-id", Permission: typesDB.PermissionRead}); err != nil {
+		t.Fatal(err)
+	}
+	if w := request(http.MethodPost, "/pastes/private-paste/forks", ""); w.Code != http.StatusCreated {
+		t.Fatalf("fork by share: status %d: %s", w.Code, w.Body)
+	}
+	pastes, err = DBInstance.GetPasteRecordsByUserId("bob-id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*pastes) != 1 || (*pastes)[0].Text != "secret text" || (*pastes)[0].ForkedFrom != "private-paste" {
+		t.Errorf("bob pastes after a fork = %+v", *pastes)
+	}
+}
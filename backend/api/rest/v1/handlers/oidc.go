@@ -0,0 +1,360 @@
+package handlers
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"pasteGo/backend/api/rest/v1/types"
+	"pasteGo/backend/audit"
+	"pasteGo/backend/auth"
+	"pasteGo/backend/config"
+	"pasteGo/backend/db"
+	"pasteGo/backend/db/typesDB"
+	"pasteGo/backend/oidc"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	cookieOIDCState   = "oidc_state"
+	oidcStateLifetime = 10 * time.Minute
+	oidcTimeout       = 15 * time.Second
+)
+
+// oidcState хранится в подписанной cookie между редиректом к провайдеру и callback
+type oidcState struct {
+	jwt.RegisteredClaims
+	State    string `json:"state"`
+	Nonce    string `json:"nonce"`
+	Verifier string `json:"verifier"`
+	Redirect string `json:"redirect,omitempty"`
+	Link     string `json:"link,omitempty"` //Id пользователя, к которому привязывается учётная запись
+}
+
+// OIDCLogin перенаправляет браузер на страницу входа провайдера.
+// ?redirect= - путь, куда вернуться после входа
+func OIDCLogin(c *gin.Context) {
+	startOIDC(c, "")
+}
+
+// LinkOIDC привязывает учётную запись провайдера к текущему пользователю
+func LinkOIDC(c *gin.Context) {
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+	startOIDC(c, userDB.Id)
+}
+
+// OIDCCallback завершает вход: проверяет state, обменивает код на ID токен и находит,
+// привязывает или создаёт пользователя
+func OIDCCallback(c *gin.Context) {
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), oidcTimeout)
+	defer cancel()
+	client, err := oidc.GetClient(ctx)
+	if errors.Is(err, oidc.ErrDisabled) {
+		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
+			Code:        types.ErrSSODisabled,
+			Explanation: types.ErrSSODisabledExp,
+		})
+		return
+	}
+	if err != nil {
+		log.Printf("oidc: %s", err)
+		c.IndentedJSON(http.StatusBadGateway, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	rawState, _ := c.Cookie(cookieOIDCState)
+	c.SetCookie(cookieOIDCState, "", -1, "/", "localhost", false, true)
+	state, err := parseOIDCState(rawState)
+	if err != nil || subtle.ConstantTimeCompare([]byte(state.State), []byte(c.Query("state"))) != 1 {
+		failOIDC(c, DBInstance, "state mismatch")
+		return
+	}
+	if providerError := c.Query("error"); providerError != "" {
+		failOIDC(c, DBInstance, providerError+" "+c.Query("error_description"))
+		return
+	}
+
+	identity, err := client.Exchange(ctx, c.Query("code"), state.Verifier, state.Nonce)
+	if err != nil {
+		failOIDC(c, DBInstance, err.Error())
+		return
+	}
+	record := typesDB.IdentityRecord{
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+		UserId:   state.Link,
+		Created:  time.Now().Unix(),
+	}
+
+	if state.Link != "" {
+		linkIdentity(c, DBInstance, record, state.Redirect)
+		return
+	}
+
+	result, err := auth.Provision(DBInstance, identity.Provider, identity.Subject, identity.Username, config.OIDC.AutoProvision)
+	switch {
+	case err == nil:
+	case errors.Is(err, auth.ErrUnknownUser):
+		recordAudit(c, DBInstance, audit.Event{
+			Actor:  identity.Username,
+			Action: audit.ActionSSOFailed,
+			Detail: "no linked account for " + identity.Subject,
+		})
+		c.IndentedJSON(http.StatusForbidden, types.APIResponse{
+			Code:        types.ErrIdentityNotLinked,
+			Explanation: types.ErrIdentityNotLinkedExp,
+		})
+		return
+	//Имя занято локальным пользователем: привязать учётную запись может только он сам через /user/oidc/link
+	case errors.Is(err, auth.ErrUsernameTaken):
+		c.IndentedJSON(http.StatusConflict, types.APIResponse{
+			Code:        types.ErrExistUser,
+			Explanation: types.ErrExistUserExp,
+		})
+		return
+	default:
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	if result.Created {
+		recordAudit(c, DBInstance, audit.Event{
+			Actor:  result.User.Username,
+			Action: audit.ActionRegister,
+			Target: audit.UserTarget(result.User.Id),
+			Detail: "oidc " + identity.Provider,
+		})
+	}
+	finishOIDC(c, DBInstance, result.User, identity, state.Redirect)
+}
+
+func GetIdentities(c *gin.Context) {
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+
+	records, err := DBInstance.GetIdentityRecordsByUserId(userDB.Id)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	identities := make([]types.Identity, 0, len(records))
+	for i := range records {
+		identities = append(identities, types.Identity{
+			Provider: records[i].Provider,
+			Subject:  records[i].Subject,
+			Created:  records[i].Created,
+		})
+	}
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message:     identities,
+	})
+}
+
+func startOIDC(c *gin.Context, link string) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), oidcTimeout)
+	defer cancel()
+	client, err := oidc.GetClient(ctx)
+	if errors.Is(err, oidc.ErrDisabled) {
+		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
+			Code:        types.ErrSSODisabled,
+			Explanation: types.ErrSSODisabledExp,
+		})
+		return
+	}
+	if err != nil {
+		log.Printf("oidc: %s", err)
+		c.IndentedJSON(http.StatusBadGateway, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	state := oidcState{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(oidcStateLifetime)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+		State:    randomToken(),
+		Nonce:    randomToken(),
+		Verifier: oidc.GenerateVerifier(),
+		Redirect: localRedirect(c.Query("redirect")),
+		Link:     link,
+	}
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, state).SignedString(derivedKey("oidc-state"))
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	c.SetCookie(cookieOIDCState, signed, int(oidcStateLifetime.Seconds()), "/", "localhost", false, true)
+	c.Redirect(http.StatusFound, client.AuthCodeURL(state.State, state.Nonce, state.Verifier))
+}
+
+func parseOIDCState(raw string) (oidcState, error) {
+	state := oidcState{}
+	token, err := jwt.ParseWithClaims(raw, &state, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+		return derivedKey("oidc-state"), nil
+	})
+	if err != nil {
+		return oidcState{}, err
+	}
+	if !token.Valid || state.State == "" {
+		return oidcState{}, fmt.Errorf("state is invalid")
+	}
+	return state, nil
+}
+
+func linkIdentity(c *gin.Context, DBInstance *db.DBInstance, record typesDB.IdentityRecord, redirect string) {
+	userDB, exists, err := DBInstance.GetUserRecordById(record.UserId)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	if !exists || userDB.Deleted > 0 {
+		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
+			Code:        types.ErrUserNotFound,
+			Explanation: types.ErrUserNotFoundExp,
+		})
+		return
+	}
+
+	linked, err := DBInstance.AddIdentityRecord(&record)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	if !linked {
+		c.IndentedJSON(http.StatusConflict, types.APIResponse{
+			Code:        types.ErrIdentityLinked,
+			Explanation: types.ErrIdentityLinkedExp,
+		})
+		return
+	}
+	recordAudit(c, DBInstance, audit.Event{
+		Actor:  userDB.Username,
+		Action: audit.ActionIdentityLink,
+		Target: audit.UserTarget(userDB.Id),
+		Detail: record.Provider + " " + record.Subject,
+	})
+	c.Redirect(http.StatusFound, redirect)
+}
+
+// finishOIDC синхронизирует права администратора с группой OIDC_ADMIN_GROUP, запоминает
+// группы пользователя и выдаёт cookies. С включённой 2FA вместо cookies, как в Login, выдаётся challenge
+func finishOIDC(c *gin.Context, DBInstance *db.DBInstance, userDB typesDB.UserRecord, identity oidc.Identity, redirect string) {
+	if config.OIDC.AdminGroup != "" && !syncAdmin(c, DBInstance, &userDB, identity.InGroup(config.OIDC.AdminGroup), audit.ActorOIDC) {
+		return
+	}
+	if !syncGroups(c, DBInstance, userDB, identity.Groups, audit.ActorOIDC) {
+		return
+	}
+
+	//Провайдер мог впустить и без второго фактора, доверять ему можно только явно: OIDC_SKIP_2FA
+	if typesDB.IntToBool(userDB.TotpEnabled) && !config.OIDC.SkipTwoFactor {
+		respondLoginChallenge(c, userDB)
+		return
+	}
+
+	if !startSession(c, DBInstance, userDB) {
+		return
+	}
+	recordAudit(c, DBInstance, audit.Event{
+		Actor:  userDB.Username,
+		Action: audit.ActionLogin,
+		Target: audit.UserTarget(userDB.Id),
+		Detail: "oidc",
+	})
+	c.Redirect(http.StatusFound, redirect)
+}
+
+func failOIDC(c *gin.Context, DBInstance *db.DBInstance, reason string) {
+	recordAudit(c, DBInstance, audit.Event{
+		Action: audit.ActionSSOFailed,
+		Detail: reason,
+	})
+	c.IndentedJSON(http.StatusUnauthorized, types.APIResponse{
+		Code:        types.ErrSSO,
+		Explanation: types.ErrSSOExp,
+	})
+}
+
+// localRedirect пропускает только пути этого сервера, иначе после входа браузер
+// можно было бы увести на чужой сайт. Браузеры считают \ за / и выбрасывают из адреса
+// табуляции и переводы строк, поэтому "/\t/evil" ведёт туда же, куда "//evil"
+func localRedirect(redirect string) string {
+	unsafe := strings.ContainsFunc(redirect, func(r rune) bool {
+		return r == '\\' || r < ' ' || r == 0x7f
+	})
+	if unsafe || !strings.HasPrefix(redirect, "/") || strings.HasPrefix(redirect, "//") {
+		return config.OIDC.PostLoginURL
+	}
+	return redirect
+}
+
+func randomToken() string {
+	raw := make([]byte, 24)
+	rand.Read(raw)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
@@ -0,0 +1,453 @@
+package handlers
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"pasteGo/backend/api/rest/v1/types"
+	"pasteGo/backend/config"
+	"pasteGo/backend/db"
+	"pasteGo/backend/db/typesDB"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const testClientId = "pastego"
+
+// fakeIssuer - OpenID провайдер в памяти: discovery, JWKS и token endpoint.
+// Код авторизации выдаёт сам тест вместе с claims будущего ID токена
+type fakeIssuer struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+
+	mutex     sync.Mutex
+	codes     map[string]issuedCode
+	exchanges atomic.Int32
+}
+
+type issuedCode struct {
+	challenge string
+	claims    jwt.MapClaims
+	key       *rsa.PrivateKey //Ключ подписи ID токена, nil - ключ провайдера из JWKS
+}
+
+var (
+	issuer     *fakeIssuer
+	issuerOnce sync.Once
+)
+
+// testIssuer запускает провайдера один раз: клиент OIDC кэширует discovery на весь процесс
+func testIssuer(t *testing.T) *fakeIssuer {
+	t.Helper()
+	issuerOnce.Do(func() {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			t.Fatal(err)
+		}
+		issuer = &fakeIssuer{key: key, codes: map[string]issuedCode{}}
+		issuer.server = httptest.NewServer(issuer)
+
+		config.OIDC.Issuer = issuer.server.URL
+		config.OIDC.ClientId = testClientId
+		config.OIDC.ClientSecret = "secret"
+		config.OIDC.RedirectURL = "http://localhost/rest/oidc/callback"
+	})
+	return issuer
+}
+
+func (issuer *fakeIssuer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	switch r.URL.Path {
+	case "/.well-known/openid-configuration":
+		json.NewEncoder(w).Encode(map[string]any{
+			"issuer":                                issuer.server.URL,
+			"authorization_endpoint":                issuer.server.URL + "/authorize",
+			"token_endpoint":                        issuer.server.URL + "/token",
+			"jwks_uri":                              issuer.server.URL + "/jwks",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	case "/jwks":
+		public := issuer.key.PublicKey
+		json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "test",
+			"alg": "RS256",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(public.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes()),
+		}}})
+	case "/token":
+		issuer.exchanges.Add(1)
+		r.ParseForm()
+		issuer.mutex.Lock()
+		code, ok := issuer.codes[r.PostForm.Get("code")]
+		delete(issuer.codes, r.PostForm.Get("code"))
+		issuer.mutex.Unlock()
+		//Код одноразовый и выдан под PKCE challenge из адреса входа
+		verifier := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+		if !ok || base64.RawURLEncoding.EncodeToString(verifier[:]) != code.challenge {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, code.claims)
+		token.Header["kid"] = "test"
+		key := issuer.key
+		if code.key != nil {
+			key = code.key
+		}
+		signed, err := token.SignedString(key)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     signed,
+		})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// code выдаёт код авторизации для входа, начатого по адресу authURL.
+// claims дополняют и переопределяют claims корректного ID токена
+func (issuer *fakeIssuer) code(t *testing.T, authURL *url.URL, subject string, claims jwt.MapClaims) string {
+	t.Helper()
+	return issuer.signedCode(t, authURL, subject, claims, nil)
+}
+
+// signedCode - code, но ID токен подписывается ключом key
+func (issuer *fakeIssuer) signedCode(t *testing.T, authURL *url.URL, subject string, claims jwt.MapClaims, key *rsa.PrivateKey) string {
+	t.Helper()
+	query := authURL.Query()
+	idClaims := jwt.MapClaims{
+		"iss":                issuer.server.URL,
+		"sub":                subject,
+		"aud":                testClientId,
+		"iat":                time.Now().Unix(),
+		"exp":                time.Now().Add(time.Hour).Unix(),
+		"nonce":              query.Get("nonce"),
+		"preferred_username": subject,
+	}
+	for name, value := range claims {
+		idClaims[name] = value
+	}
+
+	code := randomToken()
+	issuer.mutex.Lock()
+	issuer.codes[code] = issuedCode{challenge: query.Get("code_challenge"), claims: idClaims, key: key}
+	issuer.mutex.Unlock()
+	return code
+}
+
+// oidcRouter - маршруты входа через OIDC. Привязка выполняется от имени пользователя
+// из заголовка X-Test-User вместо проверки access токена
+func oidcRouter(t *testing.T) (*gin.Engine, *db.DBInstance) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	testIssuer(t)
+	types.SecretKey = []byte("oidc-test-secret")
+	DBInstance := openTestDB(t)
+
+	router := gin.New()
+	router.GET("/login", OIDCLogin)
+	router.GET("/link", func(c *gin.Context) {
+		c.Set("userClaims", &jwt.RegisteredClaims{Subject: c.GetHeader("X-Test-User")})
+	}, LinkOIDC)
+	router.GET("/callback", OIDCCallback)
+	return router, DBInstance
+}
+
+// startLogin открывает path и возвращает адрес страницы входа провайдера и cookie со state
+func startLogin(t *testing.T, router *gin.Engine, path string, header http.Header) (*url.URL, *http.Cookie) {
+	t.Helper()
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, path, nil)
+	for name, values := range header {
+		r.Header[name] = values
+	}
+	router.ServeHTTP(w, r)
+	if w.Code != http.StatusFound {
+		t.Fatalf("GET %s: status %d: %s", path, w.Code, w.Body)
+	}
+
+	authURL, err := url.Parse(w.Header().Get("Location"))
+	if err != nil || !strings.HasPrefix(authURL.String(), issuer.server.URL+"/authorize?") {
+		t.Fatalf("GET %s redirected to %q", path, w.Header().Get("Location"))
+	}
+	for _, cookie := range w.Result().Cookies() {
+		if cookie.Name == cookieOIDCState {
+			return authURL, cookie
+		}
+	}
+	t.Fatalf("GET %s set no %s cookie", path, cookieOIDCState)
+	return nil, nil
+}
+
+func callback(router *gin.Engine, state string, code string, cookie *http.Cookie) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/callback?"+url.Values{"state": {state}, "code": {code}}.Encode(), nil)
+	if cookie != nil {
+		r.AddCookie(cookie)
+	}
+	router.ServeHTTP(w, r)
+	return w
+}
+
+func responseCode(t *testing.T, w *httptest.ResponseRecorder) int {
+	t.Helper()
+	var response types.APIResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatalf("response is not JSON: %s", w.Body)
+	}
+	return response.Code
+}
+
+func TestOIDCCallback(t *testing.T) {
+	router, DBInstance := oidcRouter(t)
+
+	authURL, cookie := startLogin(t, router, "/login?redirect=/pastes", nil)
+	if query := authURL.Query(); query.Get("client_id") != testClientId || query.Get("code_challenge_method") != "S256" {
+		t.Errorf("authorization request = %s", authURL.RawQuery)
+	}
+	w := callback(router, authURL.Query().Get("state"), issuer.code(t, authURL, "alice", nil), cookie)
+	if w.Code != http.StatusFound || w.Header().Get("Location") != "/pastes" {
+		t.Fatalf("callback: status %d, Location %q: %s", w.Code, w.Header().Get("Location"), w.Body)
+	}
+	userDB, exists, err := DBInstance.GetUserRecordByIdentity(issuer.server.URL, "alice")
+	if err != nil || !exists || userDB.Username != "alice" {
+		t.Fatalf("provisioned user = %+v, %t, %v", userDB, exists, err)
+	}
+
+	//State cookie одноразовая: callback её удаляет
+	for _, cookie := range w.Result().Cookies() {
+		if cookie.Name == cookieOIDCState && cookie.MaxAge >= 0 {
+			t.Errorf("state cookie is not cleared: %+v", cookie)
+		}
+	}
+}
+
+func TestOIDCCallbackBadState(t *testing.T) {
+	router, _ := oidcRouter(t)
+	authURL, cookie := startLogin(t, router, "/login", nil)
+	state := authURL.Query().Get("state")
+
+	forged, err := jwt.NewWithClaims(jwt.SigningMethodHS256, oidcState{
+		RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute))},
+		State:            state,
+		Nonce:            authURL.Query().Get("nonce"),
+	}).SignedString([]byte("another key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expired, err := jwt.NewWithClaims(jwt.SigningMethodHS256, oidcState{
+		RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Minute))},
+		State:            state,
+		Nonce:            authURL.Query().Get("nonce"),
+	}).SignedString(derivedKey("oidc-state"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	//Подпись ключом из другого назначения тоже не подходит
+	wrongPurpose, err := jwt.NewWithClaims(jwt.SigningMethodHS256, oidcState{
+		RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute))},
+		State:            state,
+	}).SignedString(derivedKey("share-link"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		state  string
+		cookie *http.Cookie
+	}{
+		{"no cookie", state, nil},
+		{"empty cookie", state, &http.Cookie{Name: cookieOIDCState}},
+		{"garbage cookie", state, &http.Cookie{Name: cookieOIDCState, Value: "not-a-jwt"}},
+		{"forged cookie", state, &http.Cookie{Name: cookieOIDCState, Value: forged}},
+		{"expired cookie", state, &http.Cookie{Name: cookieOIDCState, Value: expired}},
+		{"cookie for another purpose", state, &http.Cookie{Name: cookieOIDCState, Value: wrongPurpose}},
+		{"state mismatch", state + "x", cookie},
+		{"no state", "", cookie},
+	}
+	before := issuer.exchanges.Load()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := callback(router, tt.state, issuer.code(t, authURL, "mallory", nil), tt.cookie)
+			if w.Code != http.StatusUnauthorized || responseCode(t, w) != types.ErrSSO {
+				t.Errorf("status %d: %s", w.Code, w.Body)
+			}
+		})
+	}
+	if exchanges := issuer.exchanges.Load() - before; exchanges != 0 {
+		t.Errorf("%d codes exchanged without a valid state", exchanges)
+	}
+}
+
+func TestOIDCCallbackRejectsIdToken(t *testing.T) {
+	router, DBInstance := oidcRouter(t)
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name   string
+		claims jwt.MapClaims
+		key    *rsa.PrivateKey
+	}{
+		{"nonce mismatch", jwt.MapClaims{"nonce": "replayed-nonce"}, nil},
+		{"no nonce", jwt.MapClaims{"nonce": ""}, nil},
+		{"wrong audience", jwt.MapClaims{"aud": "another-client"}, nil},
+		{"audience list without the client", jwt.MapClaims{"aud": []string{"another-client", "third"}}, nil},
+		{"wrong issuer", jwt.MapClaims{"iss": "https://evil.example"}, nil},
+		{"expired", jwt.MapClaims{"exp": time.Now().Add(-time.Hour).Unix()}, nil},
+		{"foreign key", nil, otherKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authURL, cookie := startLogin(t, router, "/login", nil)
+			code := issuer.signedCode(t, authURL, "mallory", tt.claims, tt.key)
+			w := callback(router, authURL.Query().Get("state"), code, cookie)
+			if w.Code != http.StatusUnauthorized || responseCode(t, w) != types.ErrSSO {
+				t.Errorf("status %d: %s", w.Code, w.Body)
+			}
+			if _, exists, _ := DBInstance.GetUserRecordByIdentity(issuer.server.URL, "mallory"); exists {
+				t.Error("user provisioned from a rejected id_token")
+			}
+		})
+	}
+}
+
+func TestOIDCOpenRedirect(t *testing.T) {
+	router, _ := oidcRouter(t)
+	for _, redirect := range []string{
+		"https://evil.example/",
+		"//evil.example/",
+		"/\\evil.example/",
+		"\\\\evil.example/",
+		"/\t/evil.example/",
+		"/\n/evil.example/",
+		"/\r\n/evil.example/",
+		"/\\/evil.example/",
+		"javascript:alert(1)",
+		"evil.example",
+		"",
+	} {
+		if got := localRedirect(redirect); got != config.OIDC.PostLoginURL {
+			t.Errorf("localRedirect(%q) = %q", redirect, got)
+		}
+
+		authURL, cookie := startLogin(t, router, "/login?"+url.Values{"redirect": {redirect}}.Encode(), nil)
+		w := callback(router, authURL.Query().Get("state"), issuer.code(t, authURL, "alice", nil), cookie)
+		if w.Code != http.StatusFound || w.Header().Get("Location") != config.OIDC.PostLoginURL {
+			t.Errorf("redirect %q: status %d, Location %q", redirect, w.Code, w.Header().Get("Location"))
+		}
+	}
+
+	for _, redirect := range []string{"/", "/pastes/1?tab=forks", "/a//b", "/%2F%2Fevil.example"} {
+		if got := localRedirect(redirect); got != redirect {
+			t.Errorf("localRedirect(%q) = %q, want it kept", redirect, got)
+		}
+	}
+}
+
+func TestLinkOIDC(t *testing.T) {
+	router, DBInstance := oidcRouter(t)
+	for _, username := range []string{"alice", "bob"} {
+		if _, err := DBInstance.AddUserRecord(&typesDB.UserRecord{Id: username + "-id", Username: username}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	link := func(username string, subject string) *httptest.ResponseRecorder {
+		authURL, cookie := startLogin(t, router, "/link?redirect=/settings", http.Header{"X-Test-User": {username}})
+		return callback(router, authURL.Query().Get("state"), issuer.code(t, authURL, subject, nil), cookie)
+	}
+
+	if w := link("alice", "alice-sso"); w.Code != http.StatusFound || w.Header().Get("Location") != "/settings" {
+		t.Fatalf("link: status %d, Location %q: %s", w.Code, w.Header().Get("Location"), w.Body)
+	}
+	userDB, exists, err := DBInstance.GetUserRecordByIdentity(issuer.server.URL, "alice-sso")
+	if err != nil || !exists || userDB.Id != "alice-id" {
+		t.Fatalf("linked user = %+v, %t, %v", userDB, exists, err)
+	}
+
+	//Учётная запись провайдера уже привязана: ни к другому пользователю, ни повторно её не привязать
+	for _, username := range []string{"bob", "alice"} {
+		w := link(username, "alice-sso")
+		if w.Code != http.StatusConflict || responseCode(t, w) != types.ErrIdentityLinked {
+			t.Errorf("relink by %s: status %d: %s", username, w.Code, w.Body)
+		}
+	}
+	if userDB, _, _ := DBInstance.GetUserRecordByIdentity(issuer.server.URL, "alice-sso"); userDB.Id != "alice-id" {
+		t.Errorf("identity moved to %s", userDB.Id)
+	}
+	identities, err := DBInstance.GetIdentityRecordsByUserId("bob-id")
+	if err != nil || len(identities) != 0 {
+		t.Errorf("bob identities = %+v, %v", identities, err)
+	}
+}
+
+func TestOIDCCallbackTwoFactor(t *testing.T) {
+	router, DBInstance := oidcRouter(t)
+	skip := config.OIDC.SkipTwoFactor
+	t.Cleanup(func() { config.OIDC.SkipTwoFactor = skip })
+
+	if _, err := DBInstance.AddUserRecord(&typesDB.UserRecord{Id: "alice-id", Username: "alice"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := DBInstance.AddIdentityRecord(&typesDB.IdentityRecord{UserId: "alice-id", Provider: issuer.server.URL, Subject: "alice-sso", Created: time.Now().Unix()}); err != nil {
+		t.Fatal(err)
+	}
+	if err := DBInstance.EnableTotp("alice-id", 0, nil); err != nil {
+		t.Fatal(err)
+	}
+	login := func() *httptest.ResponseRecorder {
+		authURL, cookie := startLogin(t, router, "/login?redirect=/pastes", nil)
+		return callback(router, authURL.Query().Get("state"), issuer.code(t, authURL, "alice-sso", nil), cookie)
+	}
+	sessionCookie := func(w *httptest.ResponseRecorder) bool {
+		for _, cookie := range w.Result().Cookies() {
+			if cookie.Name == types.CookieAccessToken && cookie.Value != "" {
+				return true
+			}
+		}
+		return false
+	}
+
+	//Вход через провайдера не заменяет второй фактор: как и Login, callback выдаёт challenge
+	w := login()
+	if w.Code != http.StatusOK || sessionCookie(w) {
+		t.Fatalf("callback with 2FA: status %d, cookies %v: %s", w.Code, w.Result().Cookies(), w.Body)
+	}
+	var response struct {
+		Message types.LoginChallenge `json:"message"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil || !response.Message.TwoFactorRequired {
+		t.Fatalf("response = %s", w.Body)
+	}
+	if username, err := parseChallenge(response.Message.Challenge); err != nil || username != "alice" {
+		t.Errorf("challenge is for %q, %v", username, err)
+	}
+
+	config.OIDC.SkipTwoFactor = true
+	if w := login(); w.Code != http.StatusFound || w.Header().Get("Location") != "/pastes" || !sessionCookie(w) {
+		t.Errorf("callback with OIDC_SKIP_2FA: status %d, Location %q: %s", w.Code, w.Header().Get("Location"), w.Body)
+	}
+}
@@ -0,0 +1,21 @@
+package handlers
+
+import (
+	"net/http"
+	"pasteGo/backend/api/openapi"
+	"pasteGo/backend/api/rest/v1/types"
+
+	"github.com/gin-gonic/gin"
+)
+
+func GetOpenAPI(c *gin.Context) {
+	spec, err := openapi.JSON()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	c.Data(http.StatusOK, "application/json; charset=utf-8", spec)
+}
@@ -0,0 +1,413 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"pasteGo/backend/api/rest/v1/types"
+	"pasteGo/backend/audit"
+	"pasteGo/backend/auth"
+	"pasteGo/backend/config"
+	"pasteGo/backend/db"
+	"pasteGo/backend/db/typesDB"
+	"pasteGo/backend/mail"
+	"pasteGo/backend/ratelimit"
+	"pasteGo/backend/validation"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func GetEmail(c *gin.Context) {
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message:     types.Email{Email: userDB.Email},
+	})
+}
+
+// SetEmail меняет адрес для сброса пароля, пустая строка удаляет его
+func SetEmail(c *gin.Context) {
+	body := types.Email{}
+	if err := c.BindJSON(&body); err != nil {
+		return
+	}
+	email, violations := validation.Email(body.Email)
+	if len(violations) > 0 {
+		c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+			Code:        types.ErrEmail,
+			Explanation: types.ErrEmailExp,
+		})
+		return
+	}
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+	if !emailAvailable(c, DBInstance, email, userDB.Id) {
+		return
+	}
+
+	if email != userDB.Email {
+		userDB.Email = email
+		if err := DBInstance.EditUserRecord(&userDB); err != nil {
+			c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+				Code:        types.ErrServer,
+				Explanation: types.ErrServerExp,
+			})
+			return
+		}
+		recordAudit(c, DBInstance, audit.Event{
+			Actor:  userDB.Username,
+			Action: audit.ActionEmailChange,
+			Target: audit.UserTarget(userDB.Id),
+			Detail: email,
+		})
+	}
+
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message:     types.Email{Email: email},
+	})
+}
+
+// RequestPasswordReset отправляет ссылку сброса на адрес пользователя.
+// Ответ одинаков для существующих и несуществующих аккаунтов, чтобы по нему
+// нельзя было перебирать имена и адреса
+func RequestPasswordReset(c *gin.Context) {
+	request := types.PasswordResetRequest{}
+	if err := c.BindJSON(&request); err != nil {
+		return
+	}
+	if !config.LocalPasswords {
+		c.IndentedJSON(http.StatusForbidden, types.APIResponse{
+			Code:        types.ErrLocalPasswordsDisabled,
+			Explanation: types.ErrLocalPasswordsDisabledExp,
+		})
+		return
+	}
+	if request.Username == "" && request.Email == "" {
+		c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+			Code:        types.ErrUserEmptyCredentials,
+			Explanation: types.ErrUserEmptyCredentialsExp,
+		})
+		return
+	}
+
+	mailer, err := mail.GetMailer()
+	if errors.Is(err, mail.ErrDisabled) {
+		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
+			Code:        types.ErrMailDisabled,
+			Explanation: types.ErrMailDisabledExp,
+		})
+		return
+	}
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	if !allowAttempt(c, ratelimit.IPKey(c.ClientIP())) {
+		return
+	}
+
+	var (
+		userDB typesDB.UserRecord
+		exists bool
+	)
+	if request.Email != "" {
+		userDB, exists, err = DBInstance.GetUserRecordByEmail(strings.TrimSpace(request.Email))
+	} else {
+		userDB, exists, err = DBInstance.GetUserRecordByUsername(validation.NormalizeUsername(request.Username))
+	}
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	//Лимит на аккаунт проверяется молча: 429 выдал бы, что аккаунт существует
+	if exists && userDB.Deleted == 0 && userDB.Email != "" && allowResetMail(userDB.Id) {
+		token, expires, err := newResetToken(DBInstance, userDB.Id)
+		if err != nil {
+			c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+				Code:        types.ErrServer,
+				Explanation: types.ErrServerExp,
+			})
+			return
+		}
+		sendResetMail(mailer, userDB, token, expires)
+		recordAudit(c, DBInstance, audit.Event{
+			Actor:  userDB.Username,
+			Action: audit.ActionResetRequest,
+			Target: audit.UserTarget(userDB.Id),
+		})
+	}
+
+	c.IndentedJSON(http.StatusAccepted, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+	})
+}
+
+// ConfirmPasswordReset задаёт новый пароль по токену из письма. Все сессии
+// пользователя завершаются, войти нужно заново
+func ConfirmPasswordReset(c *gin.Context) {
+	reset := types.PasswordReset{}
+	if err := c.BindJSON(&reset); err != nil {
+		return
+	}
+	if !config.LocalPasswords {
+		c.IndentedJSON(http.StatusForbidden, types.APIResponse{
+			Code:        types.ErrLocalPasswordsDisabled,
+			Explanation: types.ErrLocalPasswordsDisabledExp,
+		})
+		return
+	}
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	ipKey := ratelimit.IPKey(c.ClientIP())
+	if !allowAttempt(c, ipKey) {
+		return
+	}
+
+	tokenHash, now := ShaHashing(reset.Token), time.Now().Unix()
+	record, exists, err := DBInstance.GetPasswordResetRecord(tokenHash, now)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	if !exists {
+		failAttempt(c, DBInstance, "", ipKey)
+		c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+			Code:        types.ErrResetToken,
+			Explanation: types.ErrResetTokenExp,
+		})
+		return
+	}
+	userDB, _, err := DBInstance.GetUserRecordById(record.UserId)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	//Слабый пароль не расходует токен: можно попробовать другой
+	if !checkViolations(c, validation.Password(reset.Password, userDB.Username)) {
+		return
+	}
+
+	_, ok, err := DBInstance.UsePasswordReset(tokenHash, auth.HashPassword(reset.Password), now)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	//Токен успели использовать параллельным запросом
+	if !ok {
+		c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+			Code:        types.ErrResetToken,
+			Explanation: types.ErrResetTokenExp,
+		})
+		return
+	}
+
+	//Владелец доказал доступ к почте, блокировка от перебора его пароля больше не нужна
+	resetAttempts(ratelimit.UserKey(userDB.Username))
+	recordAudit(c, DBInstance, audit.Event{
+		Actor:  userDB.Username,
+		Action: audit.ActionPasswordReset,
+		Target: audit.UserTarget(userDB.Id),
+	})
+
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+	})
+}
+
+// IssuePasswordReset выдаёт администратору токен сброса для пользователя.
+// Если у пользователя есть адрес и почта настроена, ссылка уходит и ему
+func IssuePasswordReset(c *gin.Context) {
+	if !config.LocalPasswords {
+		c.IndentedJSON(http.StatusForbidden, types.APIResponse{
+			Code:        types.ErrLocalPasswordsDisabled,
+			Explanation: types.ErrLocalPasswordsDisabledExp,
+		})
+		return
+	}
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	adminDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+	userDB, ok := getUserByParam(c, DBInstance)
+	if !ok {
+		return
+	}
+
+	token, expires, err := newResetToken(DBInstance, userDB.Id)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	mailed := false
+	if mailer, err := mail.GetMailer(); err == nil && userDB.Email != "" {
+		sendResetMail(mailer, userDB, token, expires)
+		mailed = true
+	}
+	recordAudit(c, DBInstance, audit.Event{
+		Actor:  adminDB.Username,
+		Action: audit.ActionResetIssue,
+		Target: audit.UserTarget(userDB.Id),
+	})
+
+	c.IndentedJSON(http.StatusCreated, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message: types.PasswordResetToken{
+			Token:   token,
+			Expires: expires,
+			Mailed:  mailed,
+		},
+	})
+}
+
+// emailAvailable проверяет, что адрес не занят другим пользователем
+func emailAvailable(c *gin.Context, DBInstance *db.DBInstance, email string, userId string) bool {
+	if email == "" {
+		return true
+	}
+	other, exists, err := DBInstance.GetUserRecordByEmail(email)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return false
+	}
+	if exists && other.Id != userId {
+		c.IndentedJSON(http.StatusConflict, types.APIResponse{
+			Code:        types.ErrExistEmail,
+			Explanation: types.ErrExistEmailExp,
+		})
+		return false
+	}
+	return true
+}
+
+func allowResetMail(userId string) bool {
+	limiter, err := ratelimit.GetLimiter()
+	if err != nil {
+		return false
+	}
+	_, ok := limiter.Allow(ratelimit.ResetKey(userId))
+	return ok
+}
+
+// newResetToken заменяет прежние токены пользователя новым. В базе хранится только хеш
+func newResetToken(DBInstance *db.DBInstance, userId string) (string, int64, error) {
+	token := randomToken()
+	now := time.Now()
+	expires := now.Add(config.PasswordResetTTL).Unix()
+	err := DBInstance.AddPasswordResetRecord(&typesDB.PasswordResetRecord{
+		TokenHash: ShaHashing(token),
+		UserId:    userId,
+		Expires:   expires,
+	}, now.Unix())
+	return token, expires, err
+}
+
+// sendResetMail отправляет письмо в фоне: время ответа не должно зависеть
+// от того, нашёлся ли аккаунт
+func sendResetMail(mailer mail.Mailer, userDB typesDB.UserRecord, token string, expires int64) {
+	link := token
+	if config.PasswordResetURL != "" {
+		separator := "?"
+		if strings.Contains(config.PasswordResetURL, "?") {
+			separator = "&"
+		}
+		link = config.PasswordResetURL + separator + "token=" + token
+	}
+	msg := mail.Message{
+		To:      userDB.Email,
+		Subject: "Password reset",
+		Body: fmt.Sprintf("Someone asked to reset the password of the pasteGo account %q.\n\n"+
+			"Use this to choose a new password. It works once and expires at %s:\n%s\n\n"+
+			"If it was not you, ignore this email. Your password has not been changed.\n",
+			userDB.Username, time.Unix(expires, 0).UTC().Format(time.RFC1123), link),
+	}
+
+	go func() {
+		if err := mailer.Send(context.Background(), msg); err != nil {
+			log.Printf("mail to %s: %s", msg.To, err)
+		}
+	}()
+}
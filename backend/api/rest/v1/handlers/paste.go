@@ -1,24 +1,47 @@
 package handlers
 
 import (
+	"encoding/json"
+	"errors"
 	"net/http"
 	"pasteGo/backend/api/rest/v1/types"
+	"pasteGo/backend/audit"
+	"pasteGo/backend/auth"
 	"pasteGo/backend/db"
 	"pasteGo/backend/db/typesDB"
+	"pasteGo/backend/detect"
+	"pasteGo/backend/expiry"
+	"pasteGo/backend/retention"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 )
 
 func GetPaste(c *gin.Context) {
 	pastePsw := types.PastePassword{}
-	pasteId := c.Param("id")
 	if err := c.BindJSON(&pastePsw); err != nil {
 		return
 	}
 
+	showPaste(c, c.Param("id"), pastePsw.Password)
+}
+
+// ReadPaste - GET вариант GetPaste для /rest/v2, пароль передаётся в заголовке X-Paste-Password
+func ReadPaste(c *gin.Context) {
+	showPaste(c, c.Param("id"), c.GetHeader(types.HeaderPastePassword))
+}
+
+func showPaste(c *gin.Context, pasteId string, password string) {
+	paste, userDB, ok := getAccessiblePaste(c, pasteId, password)
+	if !ok {
+		return
+	}
+
 	DBInstance, err := db.GetDBInstance()
 	if err != nil {
 		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
@@ -27,8 +50,7 @@ func GetPaste(c *gin.Context) {
 		})
 		return
 	}
-
-	paste, exist, err := DBInstance.GetPasteRecordById(pasteId)
+	files, err := DBInstance.GetPasteFiles(paste.Id)
 	if err != nil {
 		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
 			Code:        types.ErrServer,
@@ -36,23 +58,7 @@ func GetPaste(c *gin.Context) {
 		})
 		return
 	}
-	if !exist {
-		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
-			Code:        types.ErrPasteNotFound,
-			Explanation: types.ErrPasteNotFoundExp,
-		})
-		return
-	}
-	if paste.Lifetime > 0 && paste.Lifetime < time.Now().Unix() {
-		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
-			Code:        types.ErrPasteNotFound,
-			Explanation: types.ErrPasteNotFoundExp,
-		})
-		deletePaste(paste.Id)
-		return
-	}
-
-	userDB, exists, err := DBInstance.GetUserRecordById(paste.UserId)
+	attachments, err := DBInstance.GetAttachmentRecordsByPasteId(paste.Id)
 	if err != nil {
 		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
 			Code:        types.ErrServer,
@@ -60,70 +66,56 @@ func GetPaste(c *gin.Context) {
 		})
 		return
 	}
-	if !exists {
-		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
-			Code:        types.ErrUserNotFound,
-			Explanation: types.ErrUserNotFoundExp,
+	//Счётчик совпадает с деревом форков: скрытые и истёкшие форки не считаются
+	forks, err := visibleForks(c, DBInstance, paste)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
 		})
 		return
 	}
 
-	//Если вставка непубличная
-	if !typesDB.IntToBool(paste.Public) {
-		accessToken, err := c.Cookie(types.CookieAccessToken)
-		response := types.APIResponse{
-			Code:        types.ErrNotPublicPaste,
-			Explanation: types.ErrNotPublicPasteExp,
-		}
-		if err != nil {
-			DumpCookies(c)
-			c.IndentedJSON(http.StatusUnauthorized, response)
-			return
-		}
-
-		claims, err := ParseClaims(accessToken)
+	var html string
+	var toc []types.Heading
+	if paste.ContentType == typesDB.ContentTypeMarkdown {
+		html, toc, err = renderMarkdown(paste)
 		if err != nil {
-			DumpCookies(c)
-			c.IndentedJSON(http.StatusUnauthorized, response)
-			return
-		}
-		if claims.ExpiresAt.Unix() < time.Now().Unix() {
-			DumpCookies(c)
-			c.IndentedJSON(http.StatusUnauthorized, response)
-			return
-		}
-	}
-
-	//Первый запрос: на вставке имеется пароль, но пользователь не знает об этом
-	if paste.Password != "" && pastePsw.Password == "" {
-		c.IndentedJSON(http.StatusUnauthorized, types.APIResponse{
-			Code:        types.ErrPasswordPaste,
-			Explanation: types.ErrPasswordPasteExp,
-		})
-		return
-	} else if paste.Password != "" && pastePsw.Password != "" { //Второй запрос: указан пароль
-		if paste.Password != ShaHashing(pastePsw.Password) {
-			c.IndentedJSON(http.StatusUnauthorized, types.APIResponse{
-				Code:        types.ErrWrongPasswordPaste,
-				Explanation: types.ErrWrongPasswordPasteExp,
+			c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+				Code:        types.ErrServer,
+				Explanation: types.ErrServerExp,
 			})
 			return
 		}
 	}
 
 	respPaste := types.Paste{
-		Id:          "",
-		Author:      userDB.Username,
-		Created:     paste.Created,
-		Updated:     paste.Updated,
-		ExpTime:     0,
-		Lifetime:    "",
-		Text:        paste.Text,
-		Password:    "",
-		HasPassword: false,
-		Public:      typesDB.IntToBool(paste.Public),
-	}
-
+		Id:                 "",
+		Author:             userDB.Username,
+		Created:            paste.Created,
+		Updated:            paste.Updated,
+		ExpTime:            0,
+		Lifetime:           "",
+		Title:              paste.Title,
+		Language:           paste.Language,
+		LanguageConfidence: paste.LanguageConfidence,
+		ContentType:        paste.ContentType,
+		Text:               paste.Text,
+		Html:               html,
+		Toc:                toc,
+		Files:              pasteFilesFromRecords(files),
+		Attachments:        attachmentsFromRecords(attachments),
+		ForkedFrom:         paste.ForkedFrom,
+		Forks:              len(forks),
+		Password:           "",
+		HasPassword:        false,
+		Public:             typesDB.IntToBool(paste.Public),
+		Version:            paste.Version,
+		LegalHold:          typesDB.IntToBool(paste.LegalHold),
+		Team:               paste.TeamId,
+	}
+
+	c.Header(types.HeaderETag, pasteETag(paste.Version))
 	c.IndentedJSON(http.StatusOK, types.APIResponse{
 		Code:        types.OperationSuccess,
 		Explanation: types.OperationSuccessExp,
@@ -205,15 +197,22 @@ func GetPasteList(c *gin.Context) {
 			PastePassword = false
 		}
 		finalPasteList = append(finalPasteList, types.Paste{
-			Id:          (*pasteList)[i].Id,
-			Author:      claims.Subject,
-			Created:     (*pasteList)[i].Created,
-			Updated:     (*pasteList)[i].Updated,
-			ExpTime:     (*pasteList)[i].Lifetime,
-			Text:        (*pasteList)[i].Text,
-			Password:    "",
-			HasPassword: PastePassword,
-			Public:      typesDB.IntToBool((*pasteList)[i].Public),
+			Id:                 (*pasteList)[i].Id,
+			Author:             claims.Subject,
+			Created:            (*pasteList)[i].Created,
+			Updated:            (*pasteList)[i].Updated,
+			ExpTime:            (*pasteList)[i].Lifetime,
+			Title:              (*pasteList)[i].Title,
+			Language:           (*pasteList)[i].Language,
+			LanguageConfidence: (*pasteList)[i].LanguageConfidence,
+			ContentType:        (*pasteList)[i].ContentType,
+			Text:               (*pasteList)[i].Text,
+			ForkedFrom:         (*pasteList)[i].ForkedFrom,
+			Password:           "",
+			HasPassword:        PastePassword,
+			Public:             typesDB.IntToBool((*pasteList)[i].Public),
+			Version:            (*pasteList)[i].Version,
+			LegalHold:          typesDB.IntToBool((*pasteList)[i].LegalHold),
 		})
 	}
 
@@ -231,6 +230,25 @@ func CreatePaste(c *gin.Context) {
 	if err := c.BindJSON(&paste); err != nil {
 		return
 	}
+	if !validatePasteFiles(paste.Files) {
+		c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+			Code:        types.ErrPasteFiles,
+			Explanation: types.ErrPasteFilesExp,
+		})
+		return
+	}
+	if !validContentType(&paste.ContentType) {
+		c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+			Code:        types.ErrContentType,
+			Explanation: types.ErrContentTypeExp,
+		})
+		return
+	}
+	expires, ok := pasteExpires(c, paste.Lifetime, time.Now())
+	if !ok {
+		return
+	}
+	detectLanguage(&paste)
 
 	DBInstance, err := db.GetDBInstance()
 	if err != nil {
@@ -277,38 +295,43 @@ func CreatePaste(c *gin.Context) {
 		return
 	}
 
-	timeNow := time.Now()
-	var expires int64 = -1
-	switch paste.Lifetime {
-	case "minute":
-		expires = timeNow.Add(time.Minute).Unix()
-	case "hour":
-		expires = timeNow.Add(time.Hour).Unix()
-	case "day":
-		expires = timeNow.Add(time.Hour * 24).Unix()
-	case "week":
-		expires = timeNow.Add(time.Hour * 24 * 7).Unix()
-	case "month":
-		expires = timeNow.Add(time.Hour * 24 * 30).Unix()
-	case "year":
-		expires = timeNow.Add(time.Hour * 24 * 365).Unix()
+	//Вставку команды создают её владельцы и редакторы
+	if paste.Team != "" {
+		if _, ok := getMemberTeam(c, DBInstance, paste.Team, userDB, typesDB.TeamRoleOwner, typesDB.TeamRoleEditor); !ok {
+			return
+		}
+	}
+
+	if !checkQuota(c, DBInstance, userDB.Id, 1, pasteSize(paste.Text, paste.Files)) {
+		return
+	}
+	warnings, ok := scanSecrets(c, &paste, paste.Files)
+	if !ok {
+		return
 	}
 
+	timeNow := time.Now()
+
 	if paste.HasPassword && paste.Password != "" {
-		paste.Password = ShaHashing(paste.Password)
+		paste.Password = auth.HashPassword(paste.Password)
 	} else {
 		paste.Password = ""
 	}
 
 	pasteRecord := typesDB.PasteRecord{
-		Id:       uuid.New().String(),
-		UserId:   userDB.Id,
-		Text:     paste.Text,
-		Created:  timeNow.Unix(),
-		Updated:  -1,
-		Lifetime: expires,
-		Password: paste.Password,
-		Public:   typesDB.BoolToInt(paste.Public),
+		Id:                 uuid.New().String(),
+		UserId:             userDB.Id,
+		Title:              paste.Title,
+		Language:           paste.Language,
+		LanguageConfidence: paste.LanguageConfidence,
+		ContentType:        paste.ContentType,
+		Text:               paste.Text,
+		Created:            timeNow.Unix(),
+		Updated:            -1,
+		Lifetime:           expires,
+		Password:           paste.Password,
+		Public:             typesDB.BoolToInt(paste.Public),
+		TeamId:             paste.Team,
 	}
 
 	created, err := DBInstance.AddPasteRecord(&pasteRecord)
@@ -319,21 +342,40 @@ func CreatePaste(c *gin.Context) {
 		})
 		return
 	}
+	if len(paste.Files) > 0 {
+		err = DBInstance.SetPasteFiles(pasteRecord.Id, pasteFileRecords(paste.Files))
+		if err != nil {
+			c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+				Code:        types.ErrServer,
+				Explanation: types.ErrServerExp,
+			})
+			return
+		}
+	}
 
+	c.Header(types.HeaderETag, pasteETag(pasteRecord.Version))
 	c.IndentedJSON(http.StatusCreated, types.APIResponse{
 		Code:        types.OperationSuccess,
 		Explanation: types.OperationSuccessExp,
 		Message: types.Paste{
-			Id:          pasteRecord.Id,
-			Author:      claims.Subject,
-			Created:     pasteRecord.Created,
-			Updated:     pasteRecord.Updated,
-			ExpTime:     pasteRecord.Lifetime,
-			Lifetime:    paste.Lifetime,
-			Text:        pasteRecord.Text,
-			Password:    "",
-			HasPassword: paste.HasPassword,
-			Public:      paste.Public,
+			Id:                 pasteRecord.Id,
+			Author:             claims.Subject,
+			Created:            pasteRecord.Created,
+			Updated:            pasteRecord.Updated,
+			ExpTime:            pasteRecord.Lifetime,
+			Lifetime:           paste.Lifetime,
+			Title:              pasteRecord.Title,
+			Language:           pasteRecord.Language,
+			LanguageConfidence: pasteRecord.LanguageConfidence,
+			ContentType:        pasteRecord.ContentType,
+			Text:               pasteRecord.Text,
+			Files:              paste.Files,
+			Warnings:           warnings,
+			Password:           "",
+			HasPassword:        paste.HasPassword,
+			Public:             paste.Public,
+			Version:            pasteRecord.Version,
+			Team:               pasteRecord.TeamId,
 		},
 	})
 }
@@ -344,6 +386,24 @@ func UpdatePaste(c *gin.Context) {
 	if err := c.BindJSON(&paste); err != nil {
 		return
 	}
+	if !validatePasteFiles(paste.Files) {
+		c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+			Code:        types.ErrPasteFiles,
+			Explanation: types.ErrPasteFilesExp,
+		})
+		return
+	}
+	if !validContentType(&paste.ContentType) {
+		c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+			Code:        types.ErrContentType,
+			Explanation: types.ErrContentTypeExp,
+		})
+		return
+	}
+	expires, ok := pasteExpires(c, paste.Lifetime, time.Now())
+	if !ok {
+		return
+	}
 
 	DBInstance, err := db.GetDBInstance()
 	if err != nil {
@@ -354,27 +414,53 @@ func UpdatePaste(c *gin.Context) {
 		return
 	}
 
-	rawClaims, exists := c.Get("userClaims")
-	if !exists {
-		c.IndentedJSON(http.StatusUnauthorized, types.APIResponse{
-			Code:        types.ErrGetCookies,
-			Explanation: types.ErrGetCookiesExp,
-		})
-		DumpCookies(c)
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
 		return
 	}
-
-	claims, ok := rawClaims.(*jwt.RegisteredClaims)
+	oldPasteRecord, permission, ok := getEditablePaste(c, DBInstance, pasteId, userDB)
 	if !ok {
-		c.IndentedJSON(http.StatusUnauthorized, types.APIResponse{
-			Code:        types.ErrJWTProcessing,
-			Explanation: types.ErrJWTProcessingExp,
+		return
+	}
+	if isDetectedLanguage(paste.Language, oldPasteRecord) {
+		paste.Language = ""
+	}
+	detectLanguage(&paste)
+	if permission == typesDB.PermissionEdit {
+		applyEditorLimits(&paste, &expires, oldPasteRecord)
+	}
+	if userDB, ok = getPasteAuthor(c, DBInstance, oldPasteRecord, userDB); !ok {
+		return
+	}
+
+	savePaste(c, DBInstance, userDB, oldPasteRecord, paste, expires)
+}
+
+// PatchPaste применяет к вставке JSON Merge Patch (RFC 7396): отсутствующие поля,
+// включая срок жизни, публичность и пароль, остаются прежними, null сбрасывает поле
+func PatchPaste(c *gin.Context) {
+	switch c.ContentType() {
+	case "", binding.MIMEJSON, types.ContentTypeMergePatch:
+	default:
+		c.IndentedJSON(http.StatusUnsupportedMediaType, types.APIResponse{
+			Code:        types.ErrPatchFormat,
+			Explanation: types.ErrPatchFormatExp,
 		})
-		DumpCookies(c)
+		return
+	}
+	body, err := c.GetRawData()
+	if err != nil {
+		c.AbortWithError(http.StatusBadRequest, err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	//Патч, не являющийся объектом, по RFC 7396 заменил бы вставку целиком - такое не принимается
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(body, &fields); err != nil || fields == nil {
+		c.AbortWithError(http.StatusBadRequest, errors.New("merge patch must be a JSON object")).SetType(gin.ErrorTypeBind)
 		return
 	}
 
-	userDB, exists, err := DBInstance.GetUserRecordByUsername(claims.Subject)
+	DBInstance, err := db.GetDBInstance()
 	if err != nil {
 		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
 			Code:        types.ErrServer,
@@ -382,15 +468,97 @@ func UpdatePaste(c *gin.Context) {
 		})
 		return
 	}
-	if !exists {
-		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
-			Code:        types.ErrUserNotFound,
-			Explanation: types.ErrUserNotFoundExp,
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+	oldPasteRecord, permission, ok := getEditablePaste(c, DBInstance, c.Param("id"), userDB)
+	if !ok {
+		return
+	}
+
+	paste := types.Paste{
+		Title:       oldPasteRecord.Title,
+		Language:    oldPasteRecord.Language,
+		ContentType: oldPasteRecord.ContentType,
+		Text:        oldPasteRecord.Text,
+		HasPassword: oldPasteRecord.Password != "",
+		Public:      typesDB.IntToBool(oldPasteRecord.Public),
+	}
+	if err := json.Unmarshal(body, &paste); err != nil {
+		c.AbortWithError(http.StatusBadRequest, err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	mergePatchNulls(&paste, fields)
+	if !validatePasteFiles(paste.Files) {
+		c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+			Code:        types.ErrPasteFiles,
+			Explanation: types.ErrPasteFilesExp,
 		})
 		return
 	}
+	if !validContentType(&paste.ContentType) {
+		c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+			Code:        types.ErrContentType,
+			Explanation: types.ErrContentTypeExp,
+		})
+		return
+	}
+	//Новый пароль без hasPassword включает защиту
+	if hasPassword, set := fields["hasPassword"]; (!set || string(hasPassword) == "null") && paste.Password != "" {
+		paste.HasPassword = true
+	}
 
-	oldPasteRecord, exists, err := DBInstance.GetPasteRecordById(pasteId)
+	//Угаданный язык определяется заново для нового текста, заданный пользователем сохраняется.
+	//Угаданный язык, который клиент прислал обратно, выбором пользователя не считается
+	_, languageSet := fields["language"]
+	_, textSet := fields["text"]
+	if languageSet && isDetectedLanguage(paste.Language, oldPasteRecord) {
+		languageSet = false
+	}
+	if !languageSet && textSet && oldPasteRecord.LanguageConfidence < detect.ConfidenceUser {
+		paste.Language = ""
+	}
+	detectLanguage(&paste)
+	if !languageSet && !textSet {
+		paste.LanguageConfidence = oldPasteRecord.LanguageConfidence
+	}
+
+	expires := oldPasteRecord.Lifetime
+	if _, set := fields["lifetime"]; set {
+		if expires, ok = pasteExpires(c, paste.Lifetime, time.Now()); !ok {
+			return
+		}
+	}
+	if permission == typesDB.PermissionEdit {
+		applyEditorLimits(&paste, &expires, oldPasteRecord)
+	}
+	if userDB, ok = getPasteAuthor(c, DBInstance, oldPasteRecord, userDB); !ok {
+		return
+	}
+
+	savePaste(c, DBInstance, userDB, oldPasteRecord, paste, expires)
+}
+
+// applyEditorLimits оставляет публичность, пароль и срок жизни вставки такими, какими их задал
+// автор: доступ edit позволяет менять только содержимое
+func applyEditorLimits(paste *types.Paste, expires *int64, oldPasteRecord typesDB.PasteRecord) {
+	paste.Public = typesDB.IntToBool(oldPasteRecord.Public)
+	paste.HasPassword = oldPasteRecord.Password != ""
+	paste.Password = ""
+	paste.Lifetime = ""
+	*expires = oldPasteRecord.Lifetime
+}
+
+// savePaste сохраняет новую версию вставки поверх oldPasteRecord от имени автора userDB:
+// квоты, секреты, пароль и файлы обрабатываются одинаково для PUT и PATCH
+func savePaste(c *gin.Context, DBInstance *db.DBInstance, userDB typesDB.UserRecord, oldPasteRecord typesDB.PasteRecord, paste types.Paste, expires int64) {
+	if !checkPasteVersion(c, paste.Version, oldPasteRecord.Version) {
+		return
+	}
+
+	oldFiles, err := DBInstance.GetPasteFiles(oldPasteRecord.Id)
 	if err != nil {
 		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
 			Code:        types.ErrServer,
@@ -398,41 +566,30 @@ func UpdatePaste(c *gin.Context) {
 		})
 		return
 	}
-	if !exists {
-		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
-			Code:        types.ErrPasteNotFound,
-			Explanation: types.ErrPasteNotFoundExp,
-		})
+
+	//Проверяется только прирост объёма относительно старой версии
+	oldSize := int64(len(oldPasteRecord.Text))
+	if paste.Files != nil {
+		oldSize += pasteFileRecordsSize(oldFiles)
+	}
+	if !checkQuota(c, DBInstance, userDB.Id, 0, pasteSize(paste.Text, paste.Files)-oldSize) {
 		return
 	}
-	if oldPasteRecord.Lifetime > 0 && oldPasteRecord.Lifetime < time.Now().Unix() {
-		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
-			Code:        types.ErrPasteNotFound,
-			Explanation: types.ErrPasteNotFoundExp,
-		})
-		deletePaste(oldPasteRecord.Id)
+
+	//Оставленные без изменений файлы тоже проверяются: вставка могла стать публичной
+	scannedFiles := paste.Files
+	if scannedFiles == nil {
+		scannedFiles = pasteFilesFromRecords(oldFiles)
+	}
+	warnings, ok := scanSecrets(c, &paste, scannedFiles)
+	if !ok {
 		return
 	}
 
 	timeNow := time.Now()
-	var expires int64 = -1
-	switch paste.Lifetime {
-	case "minute":
-		expires = timeNow.Add(time.Minute).Unix()
-	case "hour":
-		expires = timeNow.Add(time.Hour).Unix()
-	case "day":
-		expires = timeNow.Add(time.Hour * 24).Unix()
-	case "week":
-		expires = timeNow.Add(time.Hour * 24 * 7).Unix()
-	case "month":
-		expires = timeNow.Add(time.Hour * 24 * 30).Unix()
-	case "year":
-		expires = timeNow.Add(time.Hour * 24 * 365).Unix()
-	}
 
 	if paste.HasPassword && paste.Password != "" {
-		paste.Password = ShaHashing(paste.Password)
+		paste.Password = auth.HashPassword(paste.Password)
 	} else if paste.HasPassword && paste.Password == "" {
 		paste.Password = oldPasteRecord.Password
 		if oldPasteRecord.Password == "" {
@@ -446,17 +603,51 @@ func UpdatePaste(c *gin.Context) {
 	}
 
 	newPasteRecord := typesDB.PasteRecord{
-		Id:       oldPasteRecord.Id,
-		UserId:   userDB.Id,
-		Text:     paste.Text,
-		Created:  oldPasteRecord.Created,
-		Updated:  timeNow.Unix(),
-		Lifetime: expires,
-		Password: paste.Password,
-		Public:   typesDB.BoolToInt(paste.Public),
+		Id:                 oldPasteRecord.Id,
+		UserId:             userDB.Id,
+		Title:              paste.Title,
+		Language:           paste.Language,
+		LanguageConfidence: paste.LanguageConfidence,
+		ContentType:        paste.ContentType,
+		Text:               paste.Text,
+		Created:            oldPasteRecord.Created,
+		Updated:            timeNow.Unix(),
+		Lifetime:           expires,
+		Password:           paste.Password,
+		Public:             typesDB.BoolToInt(paste.Public),
+		Version:            oldPasteRecord.Version,
+	}
+
+	updated, err := DBInstance.EditPasteRecord(&newPasteRecord)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	//Вставку изменили между чтением и записью
+	if !updated {
+		current, _, err := DBInstance.GetPasteRecordById(oldPasteRecord.Id)
+		if err != nil {
+			c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+				Code:        types.ErrServer,
+				Explanation: types.ErrServerExp,
+			})
+			return
+		}
+		respondVersionConflict(c, current.Version)
+		return
 	}
 
-	err = DBInstance.EditPasteRecord(&newPasteRecord)
+	//Отсутствующее поле files оставляет файлы вставки как есть, пустой массив удаляет их
+	if paste.Files != nil {
+		err = DBInstance.SetPasteFiles(newPasteRecord.Id, pasteFileRecords(paste.Files))
+	} else {
+		var files []typesDB.PasteFileRecord
+		files, err = DBInstance.GetPasteFiles(newPasteRecord.Id)
+		paste.Files = pasteFilesFromRecords(files)
+	}
 	if err != nil {
 		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
 			Code:        types.ErrServer,
@@ -465,20 +656,29 @@ func UpdatePaste(c *gin.Context) {
 		return
 	}
 
+	c.Header(types.HeaderETag, pasteETag(newPasteRecord.Version))
 	c.IndentedJSON(http.StatusOK, types.APIResponse{
 		Code:        types.OperationSuccess,
 		Explanation: types.OperationSuccessExp,
 		Message: types.Paste{
-			Id:          newPasteRecord.Id,
-			Author:      claims.Subject,
-			Created:     newPasteRecord.Created,
-			Updated:     newPasteRecord.Updated,
-			ExpTime:     newPasteRecord.Lifetime,
-			Lifetime:    paste.Lifetime,
-			Text:        newPasteRecord.Text,
-			Password:    "",
-			HasPassword: paste.HasPassword,
-			Public:      paste.Public,
+			Id:                 newPasteRecord.Id,
+			Author:             userDB.Username,
+			Created:            newPasteRecord.Created,
+			Updated:            newPasteRecord.Updated,
+			ExpTime:            newPasteRecord.Lifetime,
+			Lifetime:           paste.Lifetime,
+			Title:              newPasteRecord.Title,
+			Language:           newPasteRecord.Language,
+			LanguageConfidence: newPasteRecord.LanguageConfidence,
+			ContentType:        newPasteRecord.ContentType,
+			Text:               newPasteRecord.Text,
+			Files:              paste.Files,
+			Warnings:           warnings,
+			Password:           "",
+			HasPassword:        paste.HasPassword,
+			Public:             paste.Public,
+			Version:            newPasteRecord.Version,
+			Team:               oldPasteRecord.TeamId,
 		},
 	})
 }
@@ -486,13 +686,61 @@ func UpdatePaste(c *gin.Context) {
 func DeletePaste(c *gin.Context) {
 	pasteId := c.Param("id")
 
-	if err := deletePaste(pasteId); err != nil {
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+	if _, ok := getOwnedPaste(c, DBInstance, pasteId, userDB); !ok {
+		return
+	}
+
+	err = retention.DeletePaste(DBInstance, pasteId)
+	if errors.Is(err, db.ErrLegalHold) {
+		retention.Record(DBInstance, typesDB.RetentionEventRecord{
+			Action:  retention.ActionDeleteDenied,
+			PasteId: pasteId,
+			UserId:  userDB.Id,
+			Actor:   userDB.Username,
+		})
+		recordAudit(c, DBInstance, audit.Event{
+			Actor:  userDB.Username,
+			Action: audit.ActionPasteDeleteDenied,
+			Target: audit.PasteTarget(pasteId),
+			Detail: "legal hold",
+		})
+		c.IndentedJSON(http.StatusConflict, types.APIResponse{
+			Code:        types.ErrPasteLegalHold,
+			Explanation: types.ErrPasteLegalHoldExp,
+		})
+		return
+	}
+	if err != nil {
 		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
 			Code:        types.ErrServer,
 			Explanation: types.ErrServerExp,
 		})
 		return
 	}
+	retention.Record(DBInstance, typesDB.RetentionEventRecord{
+		Action:  retention.ActionDelete,
+		PasteId: pasteId,
+		UserId:  userDB.Id,
+		Actor:   userDB.Username,
+	})
+	recordAudit(c, DBInstance, audit.Event{
+		Actor:  userDB.Username,
+		Action: audit.ActionPasteDelete,
+		Target: audit.PasteTarget(pasteId),
+	})
 
 	c.IndentedJSON(http.StatusOK, types.APIResponse{
 		Code:        types.OperationSuccess,
@@ -500,10 +748,147 @@ func DeletePaste(c *gin.Context) {
 	})
 }
 
+// deletePaste удаляет вставку с истёкшим сроком при обращении к ней.
+// Вставка под удержанием остаётся в базе, но клиенту уже не видна
 func deletePaste(id string) error {
 	DBInstance, err := db.GetDBInstance()
 	if err != nil {
 		return err
 	}
-	return DBInstance.DeleteRecord(id, typesDB.PastesTable)
+	return retention.DeletePaste(DBInstance, id)
+}
+
+// validContentType подставляет text вместо пустого типа содержимого
+func validContentType(contentType *string) bool {
+	switch *contentType {
+	case "":
+		*contentType = typesDB.ContentTypeText
+		return true
+	case typesDB.ContentTypeText, typesDB.ContentTypeMarkdown:
+		return true
+	}
+	return false
+}
+
+// isDetectedLanguage - язык совпадает с угаданным для вставки, а не выбранным пользователем.
+// Клиенты, отправляющие вставку целиком, возвращают его как есть
+func isDetectedLanguage(language string, old typesDB.PasteRecord) bool {
+	return language != "" && language == old.Language && old.LanguageConfidence < detect.ConfidenceUser
+}
+
+// detectLanguage угадывает незаданные языки вставки и её файлов.
+// Язык, указанный пользователем, сохраняется как есть с уверенностью 1
+func detectLanguage(paste *types.Paste) {
+	for i := range paste.Files {
+		if paste.Files[i].Language == "" {
+			paste.Files[i].Language = detect.Detect(paste.Files[i].Name, paste.Files[i].Text).Language
+		}
+	}
+
+	if paste.Language == "" && paste.ContentType == typesDB.ContentTypeMarkdown {
+		paste.Language = "markdown"
+	}
+	if paste.Language != "" {
+		paste.LanguageConfidence = detect.ConfidenceUser
+		return
+	}
+	result := detect.Detect("", paste.Text)
+	paste.Language = result.Language
+	paste.LanguageConfidence = result.Confidence
+}
+
+// mergePatchNulls сбрасывает поля, которым патч передал null: json.Unmarshal оставляет их как есть.
+// Массив files по RFC 7396 заменяется целиком, null удаляет все файлы
+func mergePatchNulls(paste *types.Paste, fields map[string]json.RawMessage) {
+	for name, value := range fields {
+		if string(value) != "null" {
+			continue
+		}
+		switch name {
+		case "title":
+			paste.Title = ""
+		case "language":
+			paste.Language = ""
+		case "contentType":
+			paste.ContentType = ""
+		case "text":
+			paste.Text = ""
+		case "lifetime":
+			paste.Lifetime = ""
+		case "password", "hasPassword":
+			paste.Password = ""
+			paste.HasPassword = false
+		case "public":
+			paste.Public = false
+		case "files":
+			paste.Files = []types.PasteFile{}
+		}
+	}
+}
+
+// checkPasteVersion сверяет версию, с которой клиент начинал правку, с текущей.
+// Версия берётся из If-Match или поля version, без неё изменение не принимается
+func checkPasteVersion(c *gin.Context, version int64, current int64) bool {
+	if match := c.GetHeader(types.HeaderIfMatch); match != "" {
+		if match == "*" {
+			return true
+		}
+		parsed, err := strconv.ParseInt(strings.Trim(strings.TrimPrefix(match, "W/"), `"`), 10, 64)
+		if err != nil {
+			parsed = -1
+		}
+		version = parsed
+	}
+	if version == 0 {
+		c.IndentedJSON(http.StatusPreconditionRequired, types.APIResponse{
+			Code:        types.ErrVersionRequired,
+			Explanation: types.ErrVersionRequiredExp,
+			Message:     types.PasteVersion{Version: current},
+		})
+		return false
+	}
+	if version != current {
+		respondVersionConflict(c, current)
+		return false
+	}
+	return true
+}
+
+func respondVersionConflict(c *gin.Context, current int64) {
+	c.Header(types.HeaderETag, pasteETag(current))
+	c.IndentedJSON(http.StatusPreconditionFailed, types.APIResponse{
+		Code:        types.ErrVersionConflict,
+		Explanation: types.ErrVersionConflictExp,
+		Message:     types.PasteVersion{Version: current},
+	})
+}
+
+func pasteETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// pasteExpires переводит срок жизни из запроса в unix время удаления.
+// Неизвестный или слишком долгий срок отклоняется, ответ уже записан
+func pasteExpires(c *gin.Context, lifetime string, timeNow time.Time) (int64, bool) {
+	expires, err := expiry.Parse(lifetime, timeNow)
+	if err != nil {
+		respondLifetimeError(c, err)
+		return 0, false
+	}
+	return expires, true
+}
+
+func respondLifetimeError(c *gin.Context, err error) {
+	if errors.Is(err, expiry.ErrTooLong) {
+		c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+			Code:        types.ErrLifetimeTooLong,
+			Explanation: types.ErrLifetimeTooLongExp,
+			Message:     types.LifetimeLimit{MaxLifetime: expiry.Maximum()},
+		})
+		return
+	}
+	c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+		Code:        types.ErrPasteLifetime,
+		Explanation: types.ErrPasteLifetimeExp,
+	})
 }
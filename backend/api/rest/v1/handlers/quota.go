@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"net/http"
+	"pasteGo/backend/api/rest/v1/types"
+	"pasteGo/backend/config"
+	"pasteGo/backend/db"
+	"pasteGo/backend/db/typesDB"
+
+	"github.com/gin-gonic/gin"
+)
+
+func GetQuota(c *gin.Context) {
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+
+	quota, err := getQuota(DBInstance, userDB.Id)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message:     quota,
+	})
+}
+
+func getQuota(DBInstance *db.DBInstance, userId string) (types.Quota, error) {
+	usage, err := DBInstance.GetUsageByUserId(userId)
+	if err != nil {
+		return types.Quota{}, err
+	}
+	override, _, err := DBInstance.GetQuotaRecord(userId)
+	if err != nil {
+		return types.Quota{}, err
+	}
+
+	quota := types.Quota{
+		Pastes:     usage.Pastes,
+		MaxPastes:  config.DefaultMaxPastes,
+		Storage:    usage.Storage,
+		MaxStorage: config.DefaultMaxStorage,
+	}
+	if override.MaxPastes.Valid {
+		quota.MaxPastes = override.MaxPastes.Int64
+	}
+	if override.MaxStorage.Valid {
+		quota.MaxStorage = override.MaxStorage.Int64
+	}
+	if quota.MaxPastes < 0 {
+		quota.MaxPastes = -1
+	}
+	if quota.MaxStorage < 0 {
+		quota.MaxStorage = -1
+	}
+	return quota, nil
+}
+
+// checkQuota проверяет, что пользователь может добавить pastes вставок и bytes байт.
+// При превышении ответ уже записан и возвращается false
+func checkQuota(c *gin.Context, DBInstance *db.DBInstance, userId string, pastes int64, bytes int64) bool {
+	quota, err := getQuota(DBInstance, userId)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return false
+	}
+
+	if pastes > 0 && quota.MaxPastes >= 0 && quota.Pastes+pastes > quota.MaxPastes {
+		c.IndentedJSON(http.StatusForbidden, types.APIResponse{
+			Code:        types.ErrPasteQuota,
+			Explanation: types.ErrPasteQuotaExp,
+			Message:     quota,
+		})
+		return false
+	}
+	if bytes > 0 && quota.MaxStorage >= 0 && quota.Storage+bytes > quota.MaxStorage {
+		c.IndentedJSON(http.StatusForbidden, types.APIResponse{
+			Code:        types.ErrStorageQuota,
+			Explanation: types.ErrStorageQuotaExp,
+			Message:     quota,
+		})
+		return false
+	}
+	return true
+}
+
+func pasteSize(text string, files []types.PasteFile) int64 {
+	size := int64(len(text))
+	for i := range files {
+		size += int64(len(files[i].Text))
+	}
+	return size
+}
+
+func pasteFileRecordsSize(records []typesDB.PasteFileRecord) int64 {
+	var size int64
+	for i := range records {
+		size += int64(len(records[i].Text))
+	}
+	return size
+}
@@ -0,0 +1,152 @@
+package handlers
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"pasteGo/backend/api/rest/v1/types"
+	"pasteGo/backend/audit"
+	"pasteGo/backend/db"
+	"pasteGo/backend/ratelimit"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// allowAttempt пропускает попытку ввода пароля, если ни один из ключей не заблокирован
+// и не исчерпал запас. Иначе отвечает 429 с Retry-After
+func allowAttempt(c *gin.Context, keys ...string) bool {
+	limiter, err := ratelimit.GetLimiter()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return false
+	}
+
+	allowed := true
+	var wait time.Duration
+	for _, key := range keys {
+		if retryAfter, ok := limiter.Allow(key); !ok {
+			allowed = false
+			wait = max(wait, retryAfter)
+		}
+	}
+	if allowed {
+		return true
+	}
+
+	seconds := int64(math.Ceil(wait.Seconds()))
+	c.Header(types.HeaderRetryAfter, strconv.FormatInt(seconds, 10))
+	c.IndentedJSON(http.StatusTooManyRequests, types.APIResponse{
+		Code:        types.ErrTooManyAttempts,
+		Explanation: types.ErrTooManyAttemptsExp,
+		Message:     types.RetryAfter{RetryAfter: seconds},
+	})
+	return false
+}
+
+// failAttempt отмечает неверный пароль по всем ключам. Новая блокировка пишется в аудит
+func failAttempt(c *gin.Context, DBInstance *db.DBInstance, actor string, keys ...string) {
+	limiter, err := ratelimit.GetLimiter()
+	if err != nil {
+		return
+	}
+	for _, key := range keys {
+		if lock := limiter.Fail(key); lock > 0 {
+			recordAudit(c, DBInstance, audit.Event{
+				Actor:  actor,
+				Action: audit.ActionLockout,
+				Detail: fmt.Sprintf("%s locked for %s", key, lock),
+			})
+		}
+	}
+}
+
+// resetAttempts забывает неудачи ключа после верного пароля.
+// Ключ IP не сбрасывается: иначе успешный вход в свой аккаунт обнулял бы перебор чужих
+func resetAttempts(key string) {
+	if limiter, err := ratelimit.GetLimiter(); err == nil {
+		limiter.Reset(key)
+	}
+}
+
+func GetLockouts(c *gin.Context) {
+	limiter, err := ratelimit.GetLimiter()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	lockouts := limiter.Lockouts()
+	sort.Slice(lockouts, func(i, j int) bool {
+		return lockouts[i].Key < lockouts[j].Key
+	})
+	result := make([]types.Lockout, 0, len(lockouts))
+	for i := range lockouts {
+		lockout := types.Lockout{
+			Key:      lockouts[i].Key,
+			Failures: lockouts[i].Failures,
+		}
+		if !lockouts[i].LockedUntil.IsZero() {
+			lockout.LockedUntil = lockouts[i].LockedUntil.Unix()
+		}
+		result = append(result, lockout)
+	}
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message:     result,
+	})
+}
+
+// ResetLockout снимает блокировку ключа :key, например user:alice
+func ResetLockout(c *gin.Context) {
+	key := c.Param("key")
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	limiter, err := ratelimit.GetLimiter()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+
+	if !limiter.Reset(key) {
+		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
+			Code:        types.ErrLockoutNotFound,
+			Explanation: types.ErrLockoutNotFoundExp,
+		})
+		return
+	}
+	recordAudit(c, DBInstance, audit.Event{
+		Actor:  userDB.Username,
+		Action: audit.ActionUnlock,
+		Detail: key,
+	})
+
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+	})
+}
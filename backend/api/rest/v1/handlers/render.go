@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"pasteGo/backend/api/rest/v1/types"
+	"pasteGo/backend/config"
+	"pasteGo/backend/db"
+	"pasteGo/backend/db/typesDB"
+	"pasteGo/backend/render"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RenderPaste отдаёт вставку подсвеченным HTML для встраивания туда, где SPA недоступно.
+// ?fragment=1 возвращает только разметку без обёртки <html>
+func RenderPaste(c *gin.Context) {
+	paste, _, ok := getAccessiblePaste(c, c.Param("id"), c.GetHeader(types.HeaderPastePassword))
+	if !ok {
+		return
+	}
+	fragment, _ := strconv.ParseBool(c.Query("fragment"))
+
+	cache := render.GetCache()
+	//Версия растёт при каждом изменении, в отличие от Updated с точностью до секунды
+	key := fmt.Sprintf("page:%s:%d:%t", paste.Id, paste.Version, fragment)
+	page, cached := cache.Get(key)
+	if !cached {
+		DBInstance, err := db.GetDBInstance()
+		if err != nil {
+			c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+				Code:        types.ErrServer,
+				Explanation: types.ErrServerExp,
+			})
+			return
+		}
+		files, err := DBInstance.GetPasteFiles(paste.Id)
+		if err != nil {
+			c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+				Code:        types.ErrServer,
+				Explanation: types.ErrServerExp,
+			})
+			return
+		}
+
+		sources := make([]render.Source, 0, len(files)+1)
+		if paste.Text != "" || len(files) == 0 {
+			sources = append(sources, render.Source{
+				Language: paste.Language,
+				Text:     paste.Text,
+				Markdown: paste.ContentType == typesDB.ContentTypeMarkdown,
+			})
+		}
+		for i := range files {
+			sources = append(sources, render.Source{
+				Name:     files[i].Name,
+				Language: files[i].Language,
+				Text:     files[i].Text,
+			})
+		}
+
+		page, err = render.Render(paste.Title, sources, render.Options{
+			Style:    config.RenderStyle,
+			Fragment: fragment,
+		})
+		if err != nil {
+			c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+				Code:        types.ErrServer,
+				Explanation: types.ErrServerExp,
+			})
+			return
+		}
+		cache.Put(key, page)
+	}
+
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Header("Content-Security-Policy", "default-src 'none'; style-src 'unsafe-inline'; img-src https:")
+	c.Data(http.StatusOK, "text/html; charset=utf-8", page.([]byte))
+}
+
+// renderMarkdown отдаёт очищенный HTML и оглавление markdown-вставки из общего кэша
+func renderMarkdown(paste typesDB.PasteRecord) (string, []types.Heading, error) {
+	cache := render.GetCache()
+	key := fmt.Sprintf("markdown:%s:%d", paste.Id, paste.Version)
+	cached, ok := cache.Get(key)
+	if !ok {
+		doc, err := render.RenderMarkdown(paste.Text, config.RenderStyle)
+		if err != nil {
+			return "", nil, err
+		}
+		cache.Put(key, doc)
+		cached = doc
+	}
+
+	doc := cached.(render.Markdown)
+	toc := make([]types.Heading, 0, len(doc.TOC))
+	for i := range doc.TOC {
+		toc = append(toc, types.Heading{
+			Level: doc.TOC[i].Level,
+			Id:    doc.TOC[i].Id,
+			Text:  doc.TOC[i].Text,
+		})
+	}
+	return doc.HTML, toc, nil
+}
@@ -0,0 +1,338 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"pasteGo/backend/api/rest/v1/types"
+	"pasteGo/backend/audit"
+	"pasteGo/backend/db"
+	"pasteGo/backend/db/typesDB"
+	"pasteGo/backend/expiry"
+	"pasteGo/backend/retention"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultRetentionEvents = 100
+	maxRetentionEvents     = 1000
+)
+
+func GetRetentionPolicies(c *gin.Context) {
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	records, err := DBInstance.GetRetentionPolicyRecords()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	policies := make([]types.RetentionPolicy, 0, len(records))
+	for i := range records {
+		policies = append(policies, retentionPolicyFromRecord(&records[i]))
+	}
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message:     policies,
+	})
+}
+
+func AddRetentionPolicy(c *gin.Context) {
+	policy := types.RetentionPolicy{}
+	if err := c.BindJSON(&policy); err != nil {
+		return
+	}
+
+	switch policy.Scope {
+	case typesDB.RetentionScopeAll, typesDB.RetentionScopePublic, typesDB.RetentionScopePrivate, typesDB.RetentionScopeDeletedUsers:
+	default:
+		c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+			Code:        types.ErrRetentionPolicy,
+			Explanation: types.ErrRetentionPolicyExp,
+			Message:     gin.H{"scope": policy.Scope},
+		})
+		return
+	}
+	if _, err := expiry.ParseDuration(policy.MaxAge); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+			Code:        types.ErrRetentionPolicy,
+			Explanation: types.ErrRetentionPolicyExp,
+			Message:     gin.H{"maxAge": policy.MaxAge},
+		})
+		return
+	}
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+
+	record := typesDB.RetentionPolicyRecord{
+		Id:      uuid.NewString(),
+		Scope:   policy.Scope,
+		MaxAge:  policy.MaxAge,
+		Created: time.Now().Unix(),
+	}
+	if err := DBInstance.AddRetentionPolicyRecord(&record); err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	retention.Record(DBInstance, typesDB.RetentionEventRecord{
+		Action:   retention.ActionPolicyAdd,
+		PolicyId: record.Id,
+		Actor:    userDB.Username,
+		Detail:   record.Scope + " " + record.MaxAge,
+	})
+	recordAudit(c, DBInstance, audit.Event{
+		Actor:  userDB.Username,
+		Action: audit.ActionRetentionPolicyAdd,
+		Target: audit.PolicyTarget(record.Id),
+		Detail: record.Scope + " " + record.MaxAge,
+	})
+
+	c.IndentedJSON(http.StatusCreated, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message:     retentionPolicyFromRecord(&record),
+	})
+}
+
+func DeleteRetentionPolicy(c *gin.Context) {
+	policyId := c.Param("policyId")
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+
+	deleted, err := DBInstance.DeleteRetentionPolicyRecord(policyId)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	if !deleted {
+		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
+			Code:        types.ErrRetentionPolicyNotFound,
+			Explanation: types.ErrRetentionPolicyNotFoundExp,
+		})
+		return
+	}
+	retention.Record(DBInstance, typesDB.RetentionEventRecord{
+		Action:   retention.ActionPolicyDelete,
+		PolicyId: policyId,
+		Actor:    userDB.Username,
+	})
+	recordAudit(c, DBInstance, audit.Event{
+		Actor:  userDB.Username,
+		Action: audit.ActionRetentionPolicyDelete,
+		Target: audit.PolicyTarget(policyId),
+	})
+
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+	})
+}
+
+// GetRetentionEvents отдаёт журнал хранения, новые события первыми.
+// ?paste= оставляет события одной вставки, ?limit= - не больше 1000
+func GetRetentionEvents(c *gin.Context) {
+	limit := defaultRetentionEvents
+	if value := c.Query("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			c.AbortWithError(http.StatusBadRequest, errors.New("limit must be a positive integer"))
+			return
+		}
+		limit = min(parsed, maxRetentionEvents)
+	}
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	records, err := DBInstance.GetRetentionEventRecords(c.Query("paste"), limit)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	events := make([]types.RetentionEvent, 0, len(records))
+	for i := range records {
+		events = append(events, types.RetentionEvent{
+			Id:       records[i].Id,
+			Time:     records[i].Time,
+			Action:   records[i].Action,
+			PolicyId: records[i].PolicyId,
+			PasteId:  records[i].PasteId,
+			UserId:   records[i].UserId,
+			Actor:    records[i].Actor,
+			Detail:   records[i].Detail,
+		})
+	}
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message:     events,
+	})
+}
+
+// RunRetention применяет политики хранения сразу, не дожидаясь фоновой проверки
+func RunRetention(c *gin.Context) {
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+
+	report, err := retention.Run(DBInstance, time.Now())
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	recordAudit(c, DBInstance, audit.Event{
+		Actor:  userDB.Username,
+		Action: audit.ActionRetentionRun,
+		Detail: fmt.Sprintf("expired %d, purged %d, users purged %d", report.Expired, report.Purged, report.UsersPurged),
+	})
+
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message: types.RetentionReport{
+			Expired:     report.Expired,
+			Purged:      report.Purged,
+			UsersPurged: report.UsersPurged,
+		},
+	})
+}
+
+func SetLegalHold(c *gin.Context) {
+	setLegalHold(c, true)
+}
+
+func ReleaseLegalHold(c *gin.Context) {
+	setLegalHold(c, false)
+}
+
+func setLegalHold(c *gin.Context, hold bool) {
+	pasteId := c.Param("id")
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+
+	updated, err := DBInstance.SetPasteLegalHold(pasteId, hold)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	if !updated {
+		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
+			Code:        types.ErrPasteNotFound,
+			Explanation: types.ErrPasteNotFoundExp,
+		})
+		return
+	}
+
+	action, auditAction := retention.ActionRelease, audit.ActionLegalHoldRelease
+	if hold {
+		action, auditAction = retention.ActionHold, audit.ActionLegalHold
+	}
+	retention.Record(DBInstance, typesDB.RetentionEventRecord{
+		Action:  action,
+		PasteId: pasteId,
+		Actor:   userDB.Username,
+	})
+	recordAudit(c, DBInstance, audit.Event{
+		Actor:  userDB.Username,
+		Action: auditAction,
+		Target: audit.PasteTarget(pasteId),
+	})
+
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message:     types.LegalHold{Id: pasteId, LegalHold: hold},
+	})
+}
+
+func retentionPolicyFromRecord(record *typesDB.RetentionPolicyRecord) types.RetentionPolicy {
+	return types.RetentionPolicy{
+		Id:      record.Id,
+		Scope:   record.Scope,
+		MaxAge:  record.MaxAge,
+		Created: record.Created,
+	}
+}
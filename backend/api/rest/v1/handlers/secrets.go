@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"net/http"
+	"pasteGo/backend/api/rest/v1/types"
+	"pasteGo/backend/config"
+	"pasteGo/backend/secrets"
+
+	"github.com/gin-gonic/gin"
+)
+
+// scanSecrets проверяет текст и файлы вставки согласно SECRET_SCAN_MODE.
+// В режиме private публичная вставка с секретами становится приватной, в режиме reject
+// ответ с найденными секретами уже записан и возвращается false
+func scanSecrets(c *gin.Context, paste *types.Paste, files []types.PasteFile) ([]types.SecretWarning, bool) {
+	if config.SecretScanMode == config.SecretScanOff {
+		return nil, true
+	}
+
+	findings := secrets.Scan("", paste.Text)
+	for i := range files {
+		findings = append(findings, secrets.Scan(files[i].Name, files[i].Text)...)
+	}
+	if len(findings) == 0 {
+		return nil, true
+	}
+
+	warnings := make([]types.SecretWarning, 0, len(findings))
+	for i := range findings {
+		warnings = append(warnings, types.SecretWarning{
+			Rule:        findings[i].Rule,
+			Description: findings[i].Description,
+			File:        findings[i].File,
+			StartLine:   findings[i].StartLine,
+			EndLine:     findings[i].EndLine,
+			Redacted:    findings[i].Redacted,
+		})
+	}
+
+	if paste.Public {
+		switch config.SecretScanMode {
+		case config.SecretScanPrivate:
+			paste.Public = false
+		case config.SecretScanReject:
+			c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+				Code:        types.ErrPasteSecrets,
+				Explanation: types.ErrPasteSecretsExp,
+				Message:     types.SecretReport{Warnings: warnings},
+			})
+			return nil, false
+		}
+	}
+	return warnings, true
+}
@@ -0,0 +1,325 @@
+package handlers
+
+import (
+	"net/http"
+	"pasteGo/backend/api/rest/v1/types"
+	"pasteGo/backend/audit"
+	"pasteGo/backend/db"
+	"pasteGo/backend/db/typesDB"
+	"pasteGo/backend/validation"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Имена групп LDAP - это DN, они бывают длинными
+const maxGroupNameLength = 1024
+
+// GetPasteShares возвращает доступы к вставке, список видит только автор
+func GetPasteShares(c *gin.Context) {
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+	paste, ok := getOwnedPaste(c, DBInstance, c.Param("id"), userDB)
+	if !ok {
+		return
+	}
+
+	records, err := DBInstance.GetShareRecords(paste.Id)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	shares := make([]types.Share, 0, len(records))
+	for i := range records {
+		shares = append(shares, shareFromRecord(&records[i]))
+	}
+
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message:     types.ShareList{Shares: shares},
+	})
+}
+
+// SharePaste выдаёт пользователю или группе доступ к вставке. Повторная выдача
+// тому же получателю меняет право
+func SharePaste(c *gin.Context) {
+	share := types.Share{}
+	if err := c.BindJSON(&share); err != nil {
+		return
+	}
+	if share.Permission == "" {
+		share.Permission = typesDB.PermissionRead
+	}
+	share.Name = strings.TrimSpace(share.Name)
+	if share.Name == "" || utf8.RuneCountInString(share.Name) > maxGroupNameLength ||
+		(share.Permission != typesDB.PermissionRead && share.Permission != typesDB.PermissionEdit) {
+		c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+			Code:        types.ErrShare,
+			Explanation: types.ErrShareExp,
+		})
+		return
+	}
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+	paste, ok := getOwnedPaste(c, DBInstance, c.Param("id"), userDB)
+	if !ok {
+		return
+	}
+
+	record := typesDB.ShareRecord{
+		Id:         uuid.NewString(),
+		PasteId:    paste.Id,
+		Permission: share.Permission,
+		Created:    time.Now().Unix(),
+	}
+	switch share.Type {
+	case typesDB.ShareTypeUser:
+		grantee, exists, err := DBInstance.GetUserRecordByUsername(validation.NormalizeUsername(share.Name))
+		if err != nil {
+			c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+				Code:        types.ErrServer,
+				Explanation: types.ErrServerExp,
+			})
+			return
+		}
+		if !exists || grantee.Deleted > 0 {
+			c.IndentedJSON(http.StatusNotFound, types.APIResponse{
+				Code:        types.ErrUserNotFound,
+				Explanation: types.ErrUserNotFoundExp,
+			})
+			return
+		}
+		//Автор и так может всё
+		if grantee.Id == userDB.Id {
+			c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+				Code:        types.ErrShare,
+				Explanation: types.ErrShareExp,
+			})
+			return
+		}
+		record.UserId, record.Username = grantee.Id, grantee.Username
+	case typesDB.ShareTypeGroup:
+		record.GroupName = share.Name
+	default:
+		c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+			Code:        types.ErrShare,
+			Explanation: types.ErrShareExp,
+		})
+		return
+	}
+
+	created, err := DBInstance.SetShareRecord(&record)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	response := shareFromRecord(&record)
+	recordAudit(c, DBInstance, audit.Event{
+		Actor:  userDB.Username,
+		Action: audit.ActionPasteShare,
+		Target: audit.PasteTarget(paste.Id),
+		Detail: response.Type + " " + response.Name + " " + response.Permission,
+	})
+
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+	c.IndentedJSON(status, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message:     response,
+	})
+}
+
+// UnsharePaste отзывает доступ к вставке
+func UnsharePaste(c *gin.Context) {
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+	paste, ok := getOwnedPaste(c, DBInstance, c.Param("id"), userDB)
+	if !ok {
+		return
+	}
+
+	records, err := DBInstance.GetShareRecords(paste.Id)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	index := slices.IndexFunc(records, func(record typesDB.ShareRecord) bool {
+		return record.Id == c.Param("shareId")
+	})
+	if index < 0 {
+		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
+			Code:        types.ErrShareNotFound,
+			Explanation: types.ErrShareNotFoundExp,
+		})
+		return
+	}
+	share := shareFromRecord(&records[index])
+
+	if _, err := DBInstance.DeleteShareRecord(paste.Id, share.Id); err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	recordAudit(c, DBInstance, audit.Event{
+		Actor:  userDB.Username,
+		Action: audit.ActionPasteUnshare,
+		Target: audit.PasteTarget(paste.Id),
+		Detail: share.Type + " " + share.Name,
+	})
+
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+	})
+}
+
+// GetSharedPastes возвращает чужие вставки, доступ к которым выдан пользователю лично
+// или его группам. Текст вставок с паролем не передаётся
+func GetSharedPastes(c *gin.Context) {
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+
+	records, err := DBInstance.GetSharedPasteRecords(userDB.Id)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	pastes := make([]types.Paste, 0, len(*records))
+	authors := make(map[string]string)
+	now := time.Now().Unix()
+	for _, record := range *records {
+		if record.Lifetime > 0 && record.Lifetime < now {
+			continue
+		}
+		permission, err := DBInstance.GetPastePermission(record.Id, userDB.Id)
+		if err != nil {
+			c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+				Code:        types.ErrServer,
+				Explanation: types.ErrServerExp,
+			})
+			return
+		}
+		author, known := authors[record.UserId]
+		if !known {
+			owner, _, err := DBInstance.GetUserRecordById(record.UserId)
+			if err != nil {
+				c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+					Code:        types.ErrServer,
+					Explanation: types.ErrServerExp,
+				})
+				return
+			}
+			author = owner.Username
+			authors[record.UserId] = author
+		}
+
+		paste := types.Paste{
+			Id:                 record.Id,
+			Author:             author,
+			Created:            record.Created,
+			Updated:            record.Updated,
+			ExpTime:            record.Lifetime,
+			Title:              record.Title,
+			Language:           record.Language,
+			LanguageConfidence: record.LanguageConfidence,
+			ContentType:        record.ContentType,
+			ForkedFrom:         record.ForkedFrom,
+			HasPassword:        record.Password != "",
+			Public:             typesDB.IntToBool(record.Public),
+			Version:            record.Version,
+			Permission:         permission,
+		}
+		if !paste.HasPassword {
+			paste.Text = record.Text
+		}
+		pastes = append(pastes, paste)
+	}
+
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message:     types.PasteList{Pastes: pastes},
+	})
+}
+
+func shareFromRecord(record *typesDB.ShareRecord) types.Share {
+	share := types.Share{
+		Id:         record.Id,
+		Type:       typesDB.ShareTypeGroup,
+		Name:       record.GroupName,
+		Permission: record.Permission,
+		Created:    record.Created,
+	}
+	if record.UserId != "" {
+		share.Type, share.Name = typesDB.ShareTypeUser, record.Username
+	}
+	return share
+}
@@ -0,0 +1,515 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"pasteGo/backend/api/rest/v1/types"
+	"pasteGo/backend/audit"
+	"pasteGo/backend/db"
+	"pasteGo/backend/db/typesDB"
+	"pasteGo/backend/validation"
+	"slices"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// GetTeams возвращает команды текущего пользователя и его роль в них
+func GetTeams(c *gin.Context) {
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+
+	records, err := DBInstance.GetTeamRecordsByUserId(userDB.Id)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	teams := make([]types.Team, 0, len(records))
+	for i := range records {
+		teams = append(teams, teamFromRecord(&records[i]))
+	}
+
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message:     types.TeamList{Teams: teams},
+	})
+}
+
+// CreateTeam создаёт команду, создатель становится её владельцем
+func CreateTeam(c *gin.Context) {
+	team := types.Team{}
+	if err := c.BindJSON(&team); err != nil {
+		return
+	}
+	name, violations := validation.TeamName(team.Name)
+	if !checkViolations(c, violations) {
+		return
+	}
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+
+	record := typesDB.TeamRecord{
+		Id:      uuid.NewString(),
+		Name:    name,
+		Created: time.Now().Unix(),
+	}
+	created, err := DBInstance.AddTeamRecord(&record, userDB.Id)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	if !created {
+		c.IndentedJSON(http.StatusConflict, types.APIResponse{
+			Code:        types.ErrExistTeam,
+			Explanation: types.ErrExistTeamExp,
+		})
+		return
+	}
+	recordAudit(c, DBInstance, audit.Event{
+		Actor:  userDB.Username,
+		Action: audit.ActionTeamCreate,
+		Target: audit.TeamTarget(record.Id),
+		Detail: record.Name,
+	})
+
+	c.IndentedJSON(http.StatusCreated, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message:     teamFromRecord(&record),
+	})
+}
+
+// GetTeam возвращает команду с составом, её видят только участники
+func GetTeam(c *gin.Context) {
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+	record, ok := getMemberTeam(c, DBInstance, c.Param("teamId"), userDB)
+	if !ok {
+		return
+	}
+
+	members, err := DBInstance.GetTeamMemberRecords(record.Id)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	team := teamFromRecord(&record)
+	team.Members = make([]types.TeamMember, 0, len(members))
+	for i := range members {
+		team.Members = append(team.Members, types.TeamMember{
+			Username: members[i].Username,
+			Role:     members[i].Role,
+			Joined:   members[i].Joined,
+		})
+	}
+
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message:     team,
+	})
+}
+
+// DeleteTeam удаляет команду. Пока у команды есть вставки, удаление отклоняется:
+// их нужно удалить заранее, чтобы ничего не пропало незаметно
+func DeleteTeam(c *gin.Context) {
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+	team, ok := getMemberTeam(c, DBInstance, c.Param("teamId"), userDB, typesDB.TeamRoleOwner)
+	if !ok {
+		return
+	}
+
+	_, err = DBInstance.DeleteTeamRecord(team.Id)
+	if errors.Is(err, db.ErrTeamNotEmpty) {
+		c.IndentedJSON(http.StatusConflict, types.APIResponse{
+			Code:        types.ErrTeamNotEmpty,
+			Explanation: types.ErrTeamNotEmptyExp,
+		})
+		return
+	}
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	recordAudit(c, DBInstance, audit.Event{
+		Actor:  userDB.Username,
+		Action: audit.ActionTeamDelete,
+		Target: audit.TeamTarget(team.Id),
+		Detail: team.Name,
+	})
+
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+	})
+}
+
+// SetTeamMember добавляет пользователя в команду или меняет его роль, это делают владельцы
+func SetTeamMember(c *gin.Context) {
+	member := types.TeamMember{}
+	if err := c.BindJSON(&member); err != nil {
+		return
+	}
+	if !validTeamRole(member.Role) {
+		c.IndentedJSON(http.StatusBadRequest, types.APIResponse{
+			Code:        types.ErrTeamRole,
+			Explanation: types.ErrTeamRoleExp,
+		})
+		return
+	}
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+	team, ok := getMemberTeam(c, DBInstance, c.Param("teamId"), userDB, typesDB.TeamRoleOwner)
+	if !ok {
+		return
+	}
+	memberDB, ok := getMemberByParam(c, DBInstance)
+	if !ok {
+		return
+	}
+
+	record := typesDB.TeamMemberRecord{
+		TeamId: team.Id,
+		UserId: memberDB.Id,
+		Role:   member.Role,
+		Joined: time.Now().Unix(),
+	}
+	added, err := DBInstance.SetTeamMember(&record)
+	if errors.Is(err, db.ErrLastOwner) {
+		c.IndentedJSON(http.StatusConflict, types.APIResponse{
+			Code:        types.ErrTeamLastOwner,
+			Explanation: types.ErrTeamLastOwnerExp,
+		})
+		return
+	}
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	action, status := audit.ActionTeamMemberRole, http.StatusOK
+	if added {
+		action, status = audit.ActionTeamMemberAdd, http.StatusCreated
+	}
+	recordAudit(c, DBInstance, audit.Event{
+		Actor:  userDB.Username,
+		Action: action,
+		Target: audit.TeamTarget(team.Id),
+		Detail: memberDB.Username + " " + record.Role,
+	})
+
+	c.IndentedJSON(status, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message: types.TeamMember{
+			Username: memberDB.Username,
+			Role:     record.Role,
+			Joined:   record.Joined,
+		},
+	})
+}
+
+// RemoveTeamMember исключает участника из команды. Владелец исключает любого,
+// остальные могут только выйти сами. Вставки, которые создал участник, остаются у команды
+func RemoveTeamMember(c *gin.Context) {
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+	team, ok := getMemberTeam(c, DBInstance, c.Param("teamId"), userDB)
+	if !ok {
+		return
+	}
+	memberDB, ok := getMemberByParam(c, DBInstance)
+	if !ok {
+		return
+	}
+	if memberDB.Id != userDB.Id && team.Role != typesDB.TeamRoleOwner {
+		c.IndentedJSON(http.StatusForbidden, types.APIResponse{
+			Code:        types.ErrTeamAccessDenied,
+			Explanation: types.ErrTeamAccessDeniedExp,
+		})
+		return
+	}
+
+	removed, err := DBInstance.DeleteTeamMember(team.Id, memberDB.Id)
+	if errors.Is(err, db.ErrLastOwner) {
+		c.IndentedJSON(http.StatusConflict, types.APIResponse{
+			Code:        types.ErrTeamLastOwner,
+			Explanation: types.ErrTeamLastOwnerExp,
+		})
+		return
+	}
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	if !removed {
+		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
+			Code:        types.ErrUserNotFound,
+			Explanation: types.ErrUserNotFoundExp,
+		})
+		return
+	}
+	recordAudit(c, DBInstance, audit.Event{
+		Actor:  userDB.Username,
+		Action: audit.ActionTeamMemberRemove,
+		Target: audit.TeamTarget(team.Id),
+		Detail: memberDB.Username,
+	})
+
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+	})
+}
+
+// GetTeamPastes возвращает вставки команды. Текст вставок с паролем не передаётся
+func GetTeamPastes(c *gin.Context) {
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+	team, ok := getMemberTeam(c, DBInstance, c.Param("teamId"), userDB)
+	if !ok {
+		return
+	}
+
+	records, err := DBInstance.GetTeamPasteRecords(team.Id)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	pastes := make([]types.Paste, 0, len(*records))
+	authors := make(map[string]string)
+	now := time.Now().Unix()
+	for _, record := range *records {
+		if record.Lifetime > 0 && record.Lifetime < now {
+			continue
+		}
+		permission, err := pastePermission(DBInstance, record, userDB)
+		if err != nil {
+			c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+				Code:        types.ErrServer,
+				Explanation: types.ErrServerExp,
+			})
+			return
+		}
+		author, known := authors[record.UserId]
+		if !known {
+			creator, _, err := DBInstance.GetUserRecordById(record.UserId)
+			if err != nil {
+				c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+					Code:        types.ErrServer,
+					Explanation: types.ErrServerExp,
+				})
+				return
+			}
+			author = creator.Username
+			authors[record.UserId] = author
+		}
+
+		paste := types.Paste{
+			Id:                 record.Id,
+			Author:             author,
+			Created:            record.Created,
+			Updated:            record.Updated,
+			ExpTime:            record.Lifetime,
+			Title:              record.Title,
+			Language:           record.Language,
+			LanguageConfidence: record.LanguageConfidence,
+			ContentType:        record.ContentType,
+			ForkedFrom:         record.ForkedFrom,
+			HasPassword:        record.Password != "",
+			Public:             typesDB.IntToBool(record.Public),
+			Version:            record.Version,
+			LegalHold:          typesDB.IntToBool(record.LegalHold),
+			Permission:         permission,
+			Team:               record.TeamId,
+		}
+		if !paste.HasPassword {
+			paste.Text = record.Text
+		}
+		pastes = append(pastes, paste)
+	}
+
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message:     types.PasteList{Pastes: pastes},
+	})
+}
+
+// getMemberTeam возвращает команду, если userDB в ней состоит, и его роль в TeamRecord.Role.
+// Для чужих команд ответ тот же, что для несуществующих. Если roles заданы, роль
+// пользователя должна быть одной из них. Если доступа нет, ответ уже записан
+func getMemberTeam(c *gin.Context, DBInstance *db.DBInstance, teamId string, userDB typesDB.UserRecord, roles ...string) (typesDB.TeamRecord, bool) {
+	team, exists, err := DBInstance.GetTeamRecordById(teamId)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return typesDB.TeamRecord{}, false
+	}
+	if exists {
+		if team.Role, err = DBInstance.GetTeamRole(team.Id, userDB.Id); err != nil {
+			c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+				Code:        types.ErrServer,
+				Explanation: types.ErrServerExp,
+			})
+			return typesDB.TeamRecord{}, false
+		}
+	}
+	if !exists || team.Role == "" {
+		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
+			Code:        types.ErrTeamNotFound,
+			Explanation: types.ErrTeamNotFoundExp,
+		})
+		return typesDB.TeamRecord{}, false
+	}
+	if len(roles) > 0 && !slices.Contains(roles, team.Role) {
+		c.IndentedJSON(http.StatusForbidden, types.APIResponse{
+			Code:        types.ErrTeamAccessDenied,
+			Explanation: types.ErrTeamAccessDeniedExp,
+		})
+		return typesDB.TeamRecord{}, false
+	}
+	return team, true
+}
+
+// getMemberByParam находит действующего пользователя по :username
+func getMemberByParam(c *gin.Context, DBInstance *db.DBInstance) (typesDB.UserRecord, bool) {
+	userDB, exists, err := DBInstance.GetUserRecordByUsername(validation.NormalizeUsername(c.Param("username")))
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return typesDB.UserRecord{}, false
+	}
+	if !exists || userDB.Deleted > 0 {
+		c.IndentedJSON(http.StatusNotFound, types.APIResponse{
+			Code:        types.ErrUserNotFound,
+			Explanation: types.ErrUserNotFoundExp,
+		})
+		return typesDB.UserRecord{}, false
+	}
+	return userDB, true
+}
+
+func validTeamRole(role string) bool {
+	switch role {
+	case typesDB.TeamRoleOwner, typesDB.TeamRoleEditor, typesDB.TeamRoleViewer:
+		return true
+	}
+	return false
+}
+
+func teamFromRecord(record *typesDB.TeamRecord) types.Team {
+	return types.Team{
+		Id:      record.Id,
+		Name:    record.Name,
+		Created: record.Created,
+		Role:    record.Role,
+	}
+}
@@ -0,0 +1,555 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"pasteGo/backend/api/rest/v1/types"
+	"pasteGo/backend/audit"
+	"pasteGo/backend/config"
+	"pasteGo/backend/db"
+	"pasteGo/backend/db/typesDB"
+	"pasteGo/backend/ratelimit"
+	"pasteGo/backend/totp"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/skip2/go-qrcode"
+)
+
+const (
+	challengeLifetime  = 5 * time.Minute
+	challengeAudience  = "2fa"
+	recoveryCodesCount = 10
+	qrCodeSize         = 256
+)
+
+// respondLoginChallenge отвечает на верный пароль пользователя с 2FA: вместо cookies
+// выдаётся короткоживущий challenge для второго шага входа
+func respondLoginChallenge(c *gin.Context, userDB typesDB.UserRecord) {
+	challenge, err := generateChallenge(userDB.Username)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message: types.LoginChallenge{
+			TwoFactorRequired: true,
+			Challenge:         challenge,
+			ExpiresIn:         int64(challengeLifetime.Seconds()),
+		},
+	})
+}
+
+// LoginTwoFactor - второй шаг входа: challenge из Login и код аутентификатора или код восстановления
+func LoginTwoFactor(c *gin.Context) {
+	login := types.TwoFactorLogin{}
+	if err := c.BindJSON(&login); err != nil {
+		return
+	}
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	username, err := parseChallenge(login.Challenge)
+	if err != nil {
+		c.IndentedJSON(http.StatusUnauthorized, types.APIResponse{
+			Code:        types.ErrTwoFactorChallenge,
+			Explanation: types.ErrTwoFactorChallengeExp,
+		})
+		return
+	}
+
+	ipKey, userKey := ratelimit.IPKey(c.ClientIP()), ratelimit.UserKey(username)
+	if !allowAttempt(c, ipKey, userKey) {
+		return
+	}
+
+	userDB, exists, err := DBInstance.GetUserRecordByUsername(username)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	//Пока challenge был действителен, 2FA могли отключить или аккаунт удалить
+	if !exists || !typesDB.IntToBool(userDB.TotpEnabled) {
+		c.IndentedJSON(http.StatusUnauthorized, types.APIResponse{
+			Code:        types.ErrTwoFactorChallenge,
+			Explanation: types.ErrTwoFactorChallengeExp,
+		})
+		return
+	}
+
+	method, ok := verifySecondFactor(c, DBInstance, userDB, login.Code, true)
+	if !ok {
+		return
+	}
+	if method == "" {
+		failAttempt(c, DBInstance, username, ipKey, userKey)
+		recordAudit(c, DBInstance, audit.Event{
+			Actor:  username,
+			Action: audit.ActionTwoFactorFail,
+			Target: audit.UserTarget(userDB.Id),
+		})
+		c.IndentedJSON(http.StatusUnauthorized, types.APIResponse{
+			Code:        types.ErrTwoFactorCode,
+			Explanation: types.ErrTwoFactorCodeExp,
+		})
+		return
+	}
+
+	if !startSession(c, DBInstance, userDB) {
+		return
+	}
+	resetAttempts(userKey)
+	recordAudit(c, DBInstance, audit.Event{
+		Actor:  username,
+		Action: audit.ActionLogin,
+		Target: audit.UserTarget(userDB.Id),
+		Detail: method,
+	})
+
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+	})
+}
+
+func GetTwoFactor(c *gin.Context) {
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+
+	left, err := DBInstance.CountRecoveryCodes(userDB.Id)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message: types.TwoFactorStatus{
+			Enabled:           typesDB.IntToBool(userDB.TotpEnabled),
+			RecoveryCodesLeft: left,
+		},
+	})
+}
+
+// EnrollTwoFactor создаёт новый секрет. 2FA включится только после ConfirmTwoFactor,
+// повторный вызов до подтверждения заменяет секрет
+func EnrollTwoFactor(c *gin.Context) {
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	uri := totp.URI(config.TotpIssuer, userDB.Username, secret)
+	png, err := qrcode.Encode(uri, qrcode.Medium, qrCodeSize)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	pending, err := DBInstance.SetPendingTotpSecret(userDB.Id, secret)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	if !pending {
+		c.IndentedJSON(http.StatusConflict, types.APIResponse{
+			Code:        types.ErrTwoFactorEnabled,
+			Explanation: types.ErrTwoFactorEnabledExp,
+		})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message: types.TwoFactorEnrollment{
+			Secret: secret,
+			Uri:    uri,
+			QrCode: "data:image/png;base64," + base64.StdEncoding.EncodeToString(png),
+		},
+	})
+}
+
+// ConfirmTwoFactor включает 2FA по первому коду из приложения и выдаёт коды восстановления
+func ConfirmTwoFactor(c *gin.Context) {
+	body := types.TwoFactorCode{}
+	if err := c.BindJSON(&body); err != nil {
+		return
+	}
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+	if typesDB.IntToBool(userDB.TotpEnabled) {
+		c.IndentedJSON(http.StatusConflict, types.APIResponse{
+			Code:        types.ErrTwoFactorEnabled,
+			Explanation: types.ErrTwoFactorEnabledExp,
+		})
+		return
+	}
+	if userDB.TotpSecret == "" {
+		c.IndentedJSON(http.StatusConflict, types.APIResponse{
+			Code:        types.ErrTwoFactorDisabled,
+			Explanation: types.ErrTwoFactorDisabledExp,
+		})
+		return
+	}
+
+	userKey := ratelimit.UserKey(userDB.Username)
+	if !allowAttempt(c, userKey) {
+		return
+	}
+	step, valid := totp.Validate(userDB.TotpSecret, body.Code, time.Now(), userDB.TotpLastStep)
+	if !valid {
+		failAttempt(c, DBInstance, userDB.Username, userKey)
+		c.IndentedJSON(http.StatusUnauthorized, types.APIResponse{
+			Code:        types.ErrTwoFactorCode,
+			Explanation: types.ErrTwoFactorCodeExp,
+		})
+		return
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	if err := DBInstance.EnableTotp(userDB.Id, step, hashes); err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	resetAttempts(userKey)
+	recordAudit(c, DBInstance, audit.Event{
+		Actor:  userDB.Username,
+		Action: audit.ActionTwoFactorOn,
+		Target: audit.UserTarget(userDB.Id),
+	})
+
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message:     types.RecoveryCodes{RecoveryCodes: codes},
+	})
+}
+
+// DisableTwoFactor отключает 2FA, если пользователь подтвердил это кодом или кодом восстановления
+func DisableTwoFactor(c *gin.Context) {
+	body := types.TwoFactorCode{}
+	if err := c.BindJSON(&body); err != nil {
+		return
+	}
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok || !checkSecondFactor(c, DBInstance, userDB, body.Code, true) {
+		return
+	}
+
+	if err := DBInstance.DisableTotp(userDB.Id); err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	recordAudit(c, DBInstance, audit.Event{
+		Actor:  userDB.Username,
+		Action: audit.ActionTwoFactorOff,
+		Target: audit.UserTarget(userDB.Id),
+	})
+
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+	})
+}
+
+// RegenerateRecoveryCodes заменяет все коды восстановления новыми. Нужен код аутентификатора
+func RegenerateRecoveryCodes(c *gin.Context) {
+	body := types.TwoFactorCode{}
+	if err := c.BindJSON(&body); err != nil {
+		return
+	}
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	userDB, ok := getCurrentUser(c, DBInstance)
+	if !ok || !checkSecondFactor(c, DBInstance, userDB, body.Code, false) {
+		return
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	if err := DBInstance.ReplaceRecoveryCodes(userDB.Id, hashes); err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	recordAudit(c, DBInstance, audit.Event{
+		Actor:  userDB.Username,
+		Action: audit.ActionRecoveryCodes,
+		Target: audit.UserTarget(userDB.Id),
+	})
+
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+		Message:     types.RecoveryCodes{RecoveryCodes: codes},
+	})
+}
+
+// ResetTwoFactor отключает 2FA пользователя, потерявшего и приложение, и коды восстановления
+func ResetTwoFactor(c *gin.Context) {
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+
+	adminDB, ok := getCurrentUser(c, DBInstance)
+	if !ok {
+		return
+	}
+	userDB, ok := getUserByParam(c, DBInstance)
+	if !ok {
+		return
+	}
+	if !typesDB.IntToBool(userDB.TotpEnabled) {
+		c.IndentedJSON(http.StatusConflict, types.APIResponse{
+			Code:        types.ErrTwoFactorDisabled,
+			Explanation: types.ErrTwoFactorDisabledExp,
+		})
+		return
+	}
+
+	if err := DBInstance.DisableTotp(userDB.Id); err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return
+	}
+	recordAudit(c, DBInstance, audit.Event{
+		Actor:  adminDB.Username,
+		Action: audit.ActionTwoFactorReset,
+		Target: audit.UserTarget(userDB.Id),
+	})
+
+	c.IndentedJSON(http.StatusOK, types.APIResponse{
+		Code:        types.OperationSuccess,
+		Explanation: types.OperationSuccessExp,
+	})
+}
+
+// checkSecondFactor проверяет код вошедшего пользователя перед изменением 2FA.
+// При неверном коде или выключенной 2FA ответ уже записан и возвращается false
+func checkSecondFactor(c *gin.Context, DBInstance *db.DBInstance, userDB typesDB.UserRecord, code string, allowRecovery bool) bool {
+	if !typesDB.IntToBool(userDB.TotpEnabled) {
+		c.IndentedJSON(http.StatusConflict, types.APIResponse{
+			Code:        types.ErrTwoFactorDisabled,
+			Explanation: types.ErrTwoFactorDisabledExp,
+		})
+		return false
+	}
+
+	userKey := ratelimit.UserKey(userDB.Username)
+	if !allowAttempt(c, userKey) {
+		return false
+	}
+	method, ok := verifySecondFactor(c, DBInstance, userDB, code, allowRecovery)
+	if !ok {
+		return false
+	}
+	if method == "" {
+		failAttempt(c, DBInstance, userDB.Username, userKey)
+		recordAudit(c, DBInstance, audit.Event{
+			Actor:  userDB.Username,
+			Action: audit.ActionTwoFactorFail,
+			Target: audit.UserTarget(userDB.Id),
+		})
+		c.IndentedJSON(http.StatusUnauthorized, types.APIResponse{
+			Code:        types.ErrTwoFactorCode,
+			Explanation: types.ErrTwoFactorCodeExp,
+		})
+		return false
+	}
+	resetAttempts(userKey)
+	return true
+}
+
+// verifySecondFactor принимает код аутентификатора, а при allowRecovery - и код восстановления,
+// который после этого удаляется. Возвращает способ ("totp", "recovery code") или пустую строку
+// для неверного кода. false - ошибка сервера, ответ уже записан
+func verifySecondFactor(c *gin.Context, DBInstance *db.DBInstance, userDB typesDB.UserRecord, code string, allowRecovery bool) (string, bool) {
+	if step, valid := totp.Validate(userDB.TotpSecret, code, time.Now(), userDB.TotpLastStep); valid {
+		//Тот же код мог прийти параллельным запросом, шаг принимается только один раз
+		used, err := DBInstance.UseTotpStep(userDB.Id, step)
+		if err != nil {
+			c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+				Code:        types.ErrServer,
+				Explanation: types.ErrServerExp,
+			})
+			return "", false
+		}
+		if used {
+			return "totp", true
+		}
+		return "", true
+	}
+	if !allowRecovery {
+		return "", true
+	}
+
+	used, err := DBInstance.UseRecoveryCode(userDB.Id, ShaHashing(totp.NormalizeRecoveryCode(code)))
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
+			Code:        types.ErrServer,
+			Explanation: types.ErrServerExp,
+		})
+		return "", false
+	}
+	if used {
+		return "recovery code", true
+	}
+	return "", true
+}
+
+// newRecoveryCodes возвращает коды для пользователя и их хеши для базы
+func newRecoveryCodes() ([]string, []string, error) {
+	codes, err := totp.RecoveryCodes(recoveryCodesCount)
+	if err != nil {
+		return nil, nil, err
+	}
+	hashes := make([]string, 0, len(codes))
+	for _, code := range codes {
+		hashes = append(hashes, ShaHashing(code))
+	}
+	return codes, hashes, nil
+}
+
+// generateChallenge подписывает challenge отдельным ключом, чтобы его нельзя было
+// предъявить как access-токен
+func generateChallenge(username string) (string, error) {
+	claims := jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(challengeLifetime)),
+		IssuedAt:  jwt.NewNumericDate(time.Now()),
+		Subject:   username,
+		Audience:  jwt.ClaimStrings{challengeAudience},
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(derivedKey("2fa-challenge"))
+}
+
+// parseChallenge проверяет challenge и возвращает имя пользователя
+func parseChallenge(challenge string) (string, error) {
+	token, err := jwt.ParseWithClaims(challenge, &jwt.RegisteredClaims{}, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+		return derivedKey("2fa-challenge"), nil
+	}, jwt.WithAudience(challengeAudience))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse challenge: %w", err)
+	}
+	claims, ok := token.Claims.(*jwt.RegisteredClaims)
+	if !ok || !token.Valid || claims.Subject == "" {
+		return "", fmt.Errorf("challenge is invalid")
+	}
+	return claims.Subject, nil
+}
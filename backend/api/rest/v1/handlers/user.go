@@ -3,8 +3,12 @@ package handlers
 import (
 	"net/http"
 	"pasteGo/backend/api/rest/v1/types"
+	"pasteGo/backend/audit"
+	"pasteGo/backend/auth"
 	"pasteGo/backend/db"
 	"pasteGo/backend/db/typesDB"
+	"pasteGo/backend/retention"
+	"pasteGo/backend/validation"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
@@ -61,7 +65,25 @@ func UpdateUser(c *gin.Context) {
 		return
 	}
 
-	_, exists, err = DBInstance.GetUserRecordByUsername(user.Username)
+	violations := make([]validation.Violation, 0)
+	if user.Username != "" {
+		username, usernameViolations := validation.Username(user.Username)
+		user.Username = username
+		violations = append(violations, usernameViolations...)
+	}
+	if user.Password != "" {
+		owner := user.Username
+		if owner == "" {
+			owner = userDB.Username
+		}
+		violations = append(violations, validation.Password(user.Password, owner)...)
+	}
+	if !checkViolations(c, violations) {
+		return
+	}
+
+	//Своё имя можно написать в другом регистре, чужое занято в любом
+	taken, err := DBInstance.IsUsernameTaken(user.Username, userDB.Id)
 	if err != nil {
 		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
 			Code:        types.ErrServer,
@@ -69,7 +91,7 @@ func UpdateUser(c *gin.Context) {
 		})
 		return
 	}
-	if exists {
+	if taken {
 		c.IndentedJSON(http.StatusConflict, types.APIResponse{
 			Code:        types.ErrExistUser,
 			Explanation: types.ErrExistUserExp,
@@ -81,6 +103,7 @@ func UpdateUser(c *gin.Context) {
 		Id:       userDB.Id,
 		Username: userDB.Username,
 		Password: userDB.Password,
+		Email:    userDB.Email,
 	}
 	if user.Password == "" && user.Username == "" {
 		c.IndentedJSON(http.StatusConflict, types.APIResponse{
@@ -90,12 +113,16 @@ func UpdateUser(c *gin.Context) {
 		return
 	}
 	if user.Password != "" {
-		newUser.Password = ShaHashing(user.Password)
+		newUser.Password = auth.HashPassword(user.Password)
 	}
 	if user.Username != "" {
 		newUser.Username = user.Username
 	}
-	if user.Username == userDB.Username || ShaHashing(user.Password) == userDB.Password {
+	samePassword := false
+	if user.Password != "" {
+		samePassword, _ = auth.CheckPassword(userDB.Password, user.Password)
+	}
+	if user.Username == userDB.Username || samePassword {
 		c.IndentedJSON(http.StatusConflict, types.APIResponse{
 			Code:        types.ErrUserSameCredentials,
 			Explanation: types.ErrUserSameCredentialsExp,
@@ -111,6 +138,21 @@ func UpdateUser(c *gin.Context) {
 		})
 		return
 	}
+	if newUser.Username != userDB.Username {
+		recordAudit(c, DBInstance, audit.Event{
+			Actor:  userDB.Username,
+			Action: audit.ActionUsernameChange,
+			Target: audit.UserTarget(userDB.Id),
+			Detail: newUser.Username,
+		})
+	}
+	if newUser.Password != userDB.Password {
+		recordAudit(c, DBInstance, audit.Event{
+			Actor:  newUser.Username,
+			Action: audit.ActionPasswordChange,
+			Target: audit.UserTarget(userDB.Id),
+		})
+	}
 
 	oldTokens, err := DBInstance.GetTokenByUserId(userDB.Id)
 	if err != nil {
@@ -124,7 +166,7 @@ func UpdateUser(c *gin.Context) {
 		DBInstance.DeleteToken((*oldTokens)[i].RefreshToken)
 	}
 
-	newTokens, err := GenerateTokens(user.Username)
+	newTokens, err := GenerateTokens(newUser.Username)
 	if err != nil {
 		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
 			Code:        types.ErrServer,
@@ -144,7 +186,7 @@ func UpdateUser(c *gin.Context) {
 		return
 	}
 
-	setCookies(c, newTokens, user.Username)
+	setCookies(c, newTokens, newUser.Username)
 
 	c.IndentedJSON(http.StatusOK, types.APIResponse{
 		Code:        types.OperationSuccess,
@@ -196,13 +238,19 @@ func DeleteUser(c *gin.Context) {
 		return
 	}
 
-	if err := DBInstance.DeleteRecord(userDB.Id, typesDB.UsersTable); err != nil {
+	//Вставки под удержанием переживают аккаунт, см. retention.DeleteUser
+	if err := retention.DeleteUser(DBInstance, userDB, userDB.Username); err != nil {
 		c.IndentedJSON(http.StatusInternalServerError, types.APIResponse{
 			Code:        types.ErrServer,
 			Explanation: types.ErrServerExp,
 		})
 		return
 	}
+	recordAudit(c, DBInstance, audit.Event{
+		Actor:  userDB.Username,
+		Action: audit.ActionUserDelete,
+		Target: audit.UserTarget(userDB.Id),
+	})
 
 	c.IndentedJSON(http.StatusOK, types.APIResponse{
 		Code:        types.OperationSuccess,
@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"net/http"
+	"pasteGo/backend/api/rest/v1/types"
+	"pasteGo/backend/validation"
+
+	"github.com/gin-gonic/gin"
+)
+
+// checkViolations отвечает 422 со списком нарушенных правил по полям.
+// Если нарушения есть, ответ уже записан и возвращается false
+func checkViolations(c *gin.Context, violations []validation.Violation) bool {
+	if len(violations) == 0 {
+		return true
+	}
+
+	fields := make([]types.FieldError, 0, len(violations))
+	for i := range violations {
+		fields = append(fields, types.FieldError{
+			Field:   violations[i].Field,
+			Rule:    violations[i].Rule,
+			Message: violations[i].Message,
+		})
+	}
+	c.IndentedJSON(http.StatusUnprocessableEntity, types.APIResponse{
+		Code:        types.ErrValidation,
+		Explanation: types.ErrValidationExp,
+		Message:     types.ValidationReport{Errors: fields},
+	})
+	return false
+}
@@ -19,6 +19,54 @@ const (
 	ErrUserEmptyCredentials    = 1005
 	ErrUserEmptyCredentialsExp = "Empty data for updating"
 
+	ErrAdminRequired    = 1006
+	ErrAdminRequiredExp = "Administrator rights required"
+
+	ErrTwoFactorCode    = 1007
+	ErrTwoFactorCodeExp = "Invalid two-factor code"
+
+	ErrTwoFactorChallenge    = 1008
+	ErrTwoFactorChallengeExp = "Two-factor challenge is invalid or expired"
+
+	ErrTwoFactorEnabled    = 1009
+	ErrTwoFactorEnabledExp = "Two-factor authentication is already enabled"
+
+	ErrTwoFactorDisabled    = 1010
+	ErrTwoFactorDisabledExp = "Two-factor authentication is not enabled"
+
+	ErrLocalPasswordsDisabled    = 1011
+	ErrLocalPasswordsDisabledExp = "Password login is disabled, use single sign-on"
+
+	ErrSSO    = 1012
+	ErrSSOExp = "Single sign-on failed"
+
+	ErrSSODisabled    = 1013
+	ErrSSODisabledExp = "Single sign-on is not configured"
+
+	ErrIdentityNotLinked    = 1014
+	ErrIdentityNotLinkedExp = "No account is linked to this identity"
+
+	ErrIdentityLinked    = 1015
+	ErrIdentityLinkedExp = "Identity is already linked to an account"
+
+	ErrLoginNotAllowed    = 1016
+	ErrLoginNotAllowedExp = "This account is not allowed to log in"
+
+	ErrEmail    = 1017
+	ErrEmailExp = "Invalid email address"
+
+	ErrExistEmail    = 1018
+	ErrExistEmailExp = "Email address is already in use"
+
+	ErrResetToken    = 1019
+	ErrResetTokenExp = "Password reset token is invalid or expired"
+
+	ErrMailDisabled    = 1020
+	ErrMailDisabledExp = "Password reset by email is not configured"
+
+	ErrValidation    = 1021
+	ErrValidationExp = "Validation failed"
+
 	ErrJWTProcessing    = 1101
 	ErrJWTProcessingExp = "JWT processing error"
 
@@ -46,6 +94,111 @@ const (
 	ErrWrongPasswordPaste    = 2005
 	ErrWrongPasswordPasteExp = "Wrong password"
 
+	ErrImportFormat    = 2006
+	ErrImportFormatExp = "Unknown import format"
+
+	ErrImportFile    = 2007
+	ErrImportFileExp = "Import file cannot be processed"
+
+	ErrPasteFiles    = 2008
+	ErrPasteFilesExp = "Invalid paste files"
+
+	ErrPasteFileNotFound    = 2009
+	ErrPasteFileNotFoundExp = "Paste file not found"
+
+	ErrAttachmentTooLarge    = 2010
+	ErrAttachmentTooLargeExp = "Attachment is too large"
+
+	ErrAttachmentNotFound    = 2011
+	ErrAttachmentNotFoundExp = "Attachment not found"
+
+	ErrPasteAccessDenied    = 2012
+	ErrPasteAccessDeniedExp = "You do not have permission to change this paste"
+
+	ErrAttachmentFile    = 2013
+	ErrAttachmentFileExp = "Attachment file is missing"
+
+	ErrPasteTooLarge    = 2014
+	ErrPasteTooLargeExp = "Paste is too large"
+
+	ErrPasteQuota    = 2015
+	ErrPasteQuotaExp = "Paste count limit reached"
+
+	ErrStorageQuota    = 2016
+	ErrStorageQuotaExp = "Storage limit reached"
+
+	ErrContentType    = 2017
+	ErrContentTypeExp = "Unknown content type"
+
+	ErrPasteSecrets    = 2018
+	ErrPasteSecretsExp = "Public paste contains secrets"
+
+	ErrPatchFormat    = 2019
+	ErrPatchFormatExp = "Patch must be sent as application/merge-patch+json"
+
+	ErrVersionRequired    = 2020
+	ErrVersionRequiredExp = "Paste version is required: send If-Match or version"
+
+	ErrVersionConflict    = 2021
+	ErrVersionConflictExp = "Paste was changed by someone else"
+
+	ErrPasteLifetime    = 2022
+	ErrPasteLifetimeExp = "Unknown paste lifetime"
+
+	ErrLifetimeTooLong    = 2023
+	ErrLifetimeTooLongExp = "Paste lifetime exceeds the allowed maximum"
+
+	ErrPasteLegalHold    = 2024
+	ErrPasteLegalHoldExp = "Paste is under legal hold and cannot be deleted"
+
+	ErrRetentionPolicy    = 2025
+	ErrRetentionPolicyExp = "Invalid retention policy"
+
+	ErrRetentionPolicyNotFound    = 2026
+	ErrRetentionPolicyNotFoundExp = "Retention policy not found"
+
+	ErrTooManyAttempts    = 2027
+	ErrTooManyAttemptsExp = "Too many attempts, try again later"
+
+	ErrLockoutNotFound    = 2028
+	ErrLockoutNotFoundExp = "No failed attempts for this key"
+
+	ErrShare    = 2044
+	ErrShareExp = "Share must name a user or group and grant read or edit"
+
+	ErrShareNotFound    = 2045
+	ErrShareNotFoundExp = "Share not found"
+
+	ErrPasteNotShared    = 2046
+	ErrPasteNotSharedExp = "This paste is not shared with you"
+
+	ErrTeamNotFound    = 2047
+	ErrTeamNotFoundExp = "Team not found"
+
+	ErrExistTeam    = 2048
+	ErrExistTeamExp = "Team name is already taken"
+
+	ErrTeamRole    = 2049
+	ErrTeamRoleExp = "Role must be owner, editor or viewer"
+
+	ErrTeamAccessDenied    = 2050
+	ErrTeamAccessDeniedExp = "Your role in the team does not allow this"
+
+	ErrTeamLastOwner    = 2051
+	ErrTeamLastOwnerExp = "A team must keep at least one owner"
+
+	ErrTeamNotEmpty    = 2052
+	ErrTeamNotEmptyExp = "Team still owns pastes"
+
+	ErrShareLink    = 2053
+	ErrShareLinkExp = "Share link needs a limited lifetime and a non-negative view limit"
+
+	ErrShareLinkNotFound    = 2054
+	ErrShareLinkNotFoundExp = "Share link not found"
+
+	ErrShareLinkInvalid    = 2055
+	ErrShareLinkInvalidExp = "Share link is invalid, expired, revoked or used up"
+
 	ErrServer    = 5000
 	ErrServerExp = "Server problem"
 )
@@ -0,0 +1,112 @@
+package types
+
+import (
+	"net/http"
+	"strconv"
+)
+
+const (
+	ContentTypeProblem = "application/problem+json"
+
+	problemTypePrefix = "urn:pastego:error:"
+)
+
+// Problem - ошибка /rest/v2 в формате RFC 7807
+type Problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	Code     int    `json:"code,omitempty"`
+	Details  any    `json:"details,omitempty"`
+}
+
+// Единый HTTP статус для каждого кода ошибки, v1 местами отвечает иначе
+var problemStatus = map[int]int{
+	ErrWrongCredentials:        http.StatusUnauthorized,
+	ErrExistUser:               http.StatusConflict,
+	ErrUserNotFound:            http.StatusNotFound,
+	ErrUserSameCredentials:     http.StatusConflict,
+	ErrUserEmptyCredentials:    http.StatusBadRequest,
+	ErrAdminRequired:           http.StatusForbidden,
+	ErrTwoFactorCode:           http.StatusUnauthorized,
+	ErrTwoFactorChallenge:      http.StatusUnauthorized,
+	ErrTwoFactorEnabled:        http.StatusConflict,
+	ErrTwoFactorDisabled:       http.StatusConflict,
+	ErrLocalPasswordsDisabled:  http.StatusForbidden,
+	ErrSSO:                     http.StatusUnauthorized,
+	ErrSSODisabled:             http.StatusNotFound,
+	ErrIdentityNotLinked:       http.StatusForbidden,
+	ErrIdentityLinked:          http.StatusConflict,
+	ErrLoginNotAllowed:         http.StatusForbidden,
+	ErrEmail:                   http.StatusBadRequest,
+	ErrExistEmail:              http.StatusConflict,
+	ErrResetToken:              http.StatusBadRequest,
+	ErrMailDisabled:            http.StatusNotFound,
+	ErrValidation:              http.StatusUnprocessableEntity,
+	ErrJWTProcessing:           http.StatusUnauthorized,
+	ErrJWTExpired:              http.StatusUnauthorized,
+	ErrJWTNotFound:             http.StatusUnauthorized,
+	ErrGetCookies:              http.StatusUnauthorized,
+	ErrEmptyPaste:              http.StatusBadRequest,
+	ErrPasteNotFound:           http.StatusNotFound,
+	ErrNotPublicPaste:          http.StatusUnauthorized,
+	ErrPasswordPaste:           http.StatusUnauthorized,
+	ErrWrongPasswordPaste:      http.StatusForbidden,
+	ErrImportFormat:            http.StatusBadRequest,
+	ErrImportFile:              http.StatusUnprocessableEntity,
+	ErrPasteFiles:              http.StatusUnprocessableEntity,
+	ErrPasteFileNotFound:       http.StatusNotFound,
+	ErrAttachmentTooLarge:      http.StatusRequestEntityTooLarge,
+	ErrAttachmentNotFound:      http.StatusNotFound,
+	ErrPasteAccessDenied:       http.StatusForbidden,
+	ErrAttachmentFile:          http.StatusBadRequest,
+	ErrPasteTooLarge:           http.StatusRequestEntityTooLarge,
+	ErrPasteQuota:              http.StatusForbidden,
+	ErrStorageQuota:            http.StatusForbidden,
+	ErrContentType:             http.StatusUnprocessableEntity,
+	ErrPasteSecrets:            http.StatusUnprocessableEntity,
+	ErrPatchFormat:             http.StatusUnsupportedMediaType,
+	ErrVersionRequired:         http.StatusPreconditionRequired,
+	ErrVersionConflict:         http.StatusPreconditionFailed,
+	ErrPasteLifetime:           http.StatusUnprocessableEntity,
+	ErrLifetimeTooLong:         http.StatusUnprocessableEntity,
+	ErrPasteLegalHold:          http.StatusConflict,
+	ErrRetentionPolicy:         http.StatusUnprocessableEntity,
+	ErrRetentionPolicyNotFound: http.StatusNotFound,
+	ErrTooManyAttempts:         http.StatusTooManyRequests,
+	ErrLockoutNotFound:         http.StatusNotFound,
+	ErrShare:                   http.StatusBadRequest,
+	ErrShareNotFound:           http.StatusNotFound,
+	ErrPasteNotShared:          http.StatusForbidden,
+	ErrTeamNotFound:            http.StatusNotFound,
+	ErrExistTeam:               http.StatusConflict,
+	ErrTeamRole:                http.StatusBadRequest,
+	ErrTeamAccessDenied:        http.StatusForbidden,
+	ErrTeamLastOwner:           http.StatusConflict,
+	ErrTeamNotEmpty:            http.StatusConflict,
+	ErrShareLink:               http.StatusBadRequest,
+	ErrShareLinkNotFound:       http.StatusNotFound,
+	ErrShareLinkInvalid:        http.StatusForbidden,
+	ErrServer:                  http.StatusInternalServerError,
+}
+
+// NewProblem собирает ошибку по коду из errors.go.
+// Для неизвестного кода используется статус, которым ответил обработчик
+func NewProblem(code int, title string, status int) Problem {
+	if mapped, ok := problemStatus[code]; ok {
+		status = mapped
+	}
+	problem := Problem{
+		Type:   problemTypePrefix + strconv.Itoa(code),
+		Title:  title,
+		Status: status,
+		Code:   code,
+	}
+	if code == 0 {
+		problem.Type = "about:blank"
+		problem.Title = http.StatusText(status)
+	}
+	return problem
+}
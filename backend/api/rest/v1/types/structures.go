@@ -5,6 +5,19 @@ const (
 	CookieRefreshToken = "refresh_token"
 	CookieUsername     = "username"
 	CookieExp          = "exp"
+
+	HeaderPastePassword = "X-Paste-Password"
+	QueryShareLink      = "link"
+
+	ContentTypeMergePatch = "application/merge-patch+json"
+	ContentTypeJSONLines  = "application/x-ndjson"
+
+	HeaderETag       = "ETag"
+	HeaderIfMatch    = "If-Match"
+	HeaderRetryAfter = "Retry-After"
+
+	MaxPasteFiles    = 50
+	MaxPasteFileName = 255
 )
 
 var SecretKey []byte
@@ -19,25 +32,314 @@ type User struct {
 	Id       string `json:"id,omitempty"`
 	Username string `json:"username"`
 	Password string `json:"password,omitempty"`
+	Email    string `json:"email,omitempty"`
 }
 
 type Paste struct {
+	Id       string `json:"id"`
+	Author   string `json:"author"`
+	Created  int64  `json:"created,omitempty"`
+	Updated  int64  `json:"updated,omitempty"`
+	ExpTime  int64  `json:"expTime"`
+	Lifetime string `json:"lifetime,omitempty"`
+	Title    string `json:"title"`
+	Language string `json:"language"`
+	//Уверенность автоопределения языка от 0 до 1, 1 - язык указан пользователем
+	LanguageConfidence float64         `json:"languageConfidence"`
+	ContentType        string          `json:"contentType"`
+	Text               string          `json:"text"`
+	Html               string          `json:"html,omitempty"`
+	Toc                []Heading       `json:"toc,omitempty"`
+	Files              []PasteFile     `json:"files,omitempty"`
+	Attachments        []Attachment    `json:"attachments,omitempty"`
+	ForkedFrom         string          `json:"forkedFrom,omitempty"`
+	Forks              int             `json:"forks,omitempty"`
+	Warnings           []SecretWarning `json:"warnings,omitempty"`
+	Password           string          `json:"password"`
+	Public             bool            `json:"public"`
+	HasPassword        bool            `json:"hasPassword"`
+	Version            int64           `json:"version,omitempty"`
+	LegalHold          bool            `json:"legalHold,omitempty"`
+	Permission         string          `json:"permission,omitempty"` //Право на чужую вставку в списке общих: read или edit
+	Team               string          `json:"team,omitempty"`       //Id команды-владельца
+}
+
+// Heading - пункт оглавления markdown-вставки, Id совпадает с id заголовка в Html
+type Heading struct {
+	Level int    `json:"level"`
+	Id    string `json:"id"`
+	Text  string `json:"text"`
+}
+
+// SecretWarning - похожий на секрет фрагмент, File пуст для основного текста
+type SecretWarning struct {
+	Rule        string `json:"rule"`
+	Description string `json:"description"`
+	File        string `json:"file,omitempty"`
+	StartLine   int    `json:"startLine"`
+	EndLine     int    `json:"endLine"`
+	Redacted    string `json:"redacted"`
+}
+
+type SecretReport struct {
+	Warnings []SecretWarning `json:"warnings"`
+}
+
+type PasteFile struct {
+	Name     string `json:"name"`
+	Language string `json:"language"`
+	Text     string `json:"text"`
+}
+
+type Attachment struct {
 	Id          string `json:"id"`
-	Author      string `json:"author"`
-	Created     int64  `json:"created,omitempty"`
-	Updated     int64  `json:"updated,omitempty"`
-	ExpTime     int64  `json:"expTime"`
-	Lifetime    string `json:"lifetime,omitempty"`
-	Text        string `json:"text"`
-	Password    string `json:"password"`
-	Public      bool   `json:"public"`
-	HasPassword bool   `json:"hasPassword"`
+	Name        string `json:"name"`
+	ContentType string `json:"contentType"`
+	Size        int64  `json:"size"`
+	Created     int64  `json:"created"`
+}
+
+// ForkNode - вставка в дереве форков, текст не передаётся
+type ForkNode struct {
+	Id          string     `json:"id"`
+	Author      string     `json:"author"`
+	Title       string     `json:"title"`
+	Created     int64      `json:"created"`
+	ExpTime     int64      `json:"expTime"`
+	Public      bool       `json:"public"`
+	HasPassword bool       `json:"hasPassword"`
+	Forks       []ForkNode `json:"forks"`
 }
 
 type PasteList struct {
 	Pastes []Paste `json:"pastes"`
 }
 
+// Share - доступ к вставке, выданный пользователю (Type user, Name - имя) или группе
+// LDAP/OIDC (Type group, Name - имя группы). Permission: read или edit
+type Share struct {
+	Id         string `json:"id,omitempty"`
+	Type       string `json:"type"`
+	Name       string `json:"name"`
+	Permission string `json:"permission,omitempty"`
+	Created    int64  `json:"created,omitempty"`
+}
+
+type ShareList struct {
+	Shares []Share `json:"shares"`
+}
+
+// ShareLink - ссылка на вставку без входа. Lifetime задаётся при создании, Token - часть ссылки ?link=
+type ShareLink struct {
+	Id       string `json:"id,omitempty"`
+	Token    string `json:"token,omitempty"`
+	Lifetime string `json:"lifetime,omitempty"`
+	Expires  int64  `json:"expires,omitempty"`
+	MaxViews int64  `json:"maxViews"`
+	Views    int64  `json:"views"`
+	Created  int64  `json:"created,omitempty"`
+}
+
+type ShareLinkList struct {
+	Links []ShareLink `json:"links"`
+}
+
+// Team - команда. Role - роль текущего пользователя, Members заполняется только для одной команды
+type Team struct {
+	Id      string       `json:"id,omitempty"`
+	Name    string       `json:"name"`
+	Created int64        `json:"created,omitempty"`
+	Role    string       `json:"role,omitempty"`
+	Members []TeamMember `json:"members,omitempty"`
+}
+
+type TeamList struct {
+	Teams []Team `json:"teams"`
+}
+
+type TeamMember struct {
+	Username string `json:"username,omitempty"`
+	Role     string `json:"role"`
+	Joined   int64  `json:"joined,omitempty"`
+}
+
+type ImportResult struct {
+	Imported int     `json:"imported"`
+	Pastes   []Paste `json:"pastes"`
+}
+
+// Quota - использование и лимиты пользователя, -1 означает без ограничений
+type Quota struct {
+	Pastes     int64 `json:"pastes"`
+	MaxPastes  int64 `json:"maxPastes"`
+	Storage    int64 `json:"storage"`
+	MaxStorage int64 `json:"maxStorage"`
+}
+
+// QuotaOverride - индивидуальные лимиты, null возвращает значение по умолчанию
+type QuotaOverride struct {
+	MaxPastes  *int64 `json:"maxPastes"`
+	MaxStorage *int64 `json:"maxStorage"`
+}
+
+type UserQuota struct {
+	Username string        `json:"username"`
+	Quota    Quota         `json:"quota"`
+	Override QuotaOverride `json:"override"`
+}
+
+// ExpiryChange - новый срок жизни вставки: lifetime отсчитывается от текущего момента,
+// extend добавляется к текущему времени удаления. Нужно одно из двух полей
+type ExpiryChange struct {
+	Lifetime string `json:"lifetime,omitempty"`
+	Extend   string `json:"extend,omitempty"`
+}
+
+type PasteExpiry struct {
+	Id      string `json:"id"`
+	ExpTime int64  `json:"expTime"`
+}
+
+type LifetimeLimit struct {
+	MaxLifetime string `json:"maxLifetime"`
+}
+
+// PasteVersion - текущая версия вставки в ответе на конфликт правок
+type PasteVersion struct {
+	Version int64 `json:"version"`
+}
+
+// RetentionPolicy - политика хранения: вставки области scope удаляются через maxAge
+// после создания, для deleted_users - через maxAge после закрытия аккаунта
+type RetentionPolicy struct {
+	Id      string `json:"id"`
+	Scope   string `json:"scope"`
+	MaxAge  string `json:"maxAge"`
+	Created int64  `json:"created"`
+}
+
+type RetentionEvent struct {
+	Id       int64  `json:"id"`
+	Time     int64  `json:"time"`
+	Action   string `json:"action"`
+	PolicyId string `json:"policyId,omitempty"`
+	PasteId  string `json:"pasteId,omitempty"`
+	UserId   string `json:"userId,omitempty"`
+	Actor    string `json:"actor,omitempty"`
+	Detail   string `json:"detail,omitempty"`
+}
+
+// RetentionReport - итог ручного запуска проверки хранения
+type RetentionReport struct {
+	Expired     int `json:"expired"`
+	Purged      int `json:"purged"`
+	UsersPurged int `json:"usersPurged"`
+}
+
+type AuditEvent struct {
+	Id        int64  `json:"id"`
+	Time      int64  `json:"time"`
+	Actor     string `json:"actor"`
+	Action    string `json:"action"`
+	Target    string `json:"target,omitempty"`
+	Ip        string `json:"ip,omitempty"`
+	UserAgent string `json:"userAgent,omitempty"`
+	Detail    string `json:"detail,omitempty"`
+}
+
+// RetryAfter - через сколько секунд можно повторить попытку, то же значение в заголовке Retry-After
+type RetryAfter struct {
+	RetryAfter int64 `json:"retryAfter"`
+}
+
+// Lockout - неудачные попытки по ключу ip:<адрес>, user:<имя> или paste:<id>
+type Lockout struct {
+	Key         string `json:"key"`
+	Failures    int    `json:"failures"`
+	LockedUntil int64  `json:"lockedUntil,omitempty"`
+}
+
+// LoginChallenge - ответ на верный пароль при включённой 2FA. Cookies выдаются
+// только после того, как challenge вместе с кодом отправлен на /auth/2fa
+type LoginChallenge struct {
+	TwoFactorRequired bool   `json:"twoFactorRequired"`
+	Challenge         string `json:"challenge"`
+	ExpiresIn         int64  `json:"expiresIn"`
+}
+
+// TwoFactorLogin - второй шаг входа, Code - код аутентификатора или код восстановления
+type TwoFactorLogin struct {
+	Challenge string `json:"challenge"`
+	Code      string `json:"code"`
+}
+
+type TwoFactorCode struct {
+	Code string `json:"code"`
+}
+
+type TwoFactorStatus struct {
+	Enabled           bool `json:"enabled"`
+	RecoveryCodesLeft int  `json:"recoveryCodesLeft"`
+}
+
+// TwoFactorEnrollment - новый секрет: Uri для приложения, QrCode - тот же Uri картинкой (data:image/png)
+type TwoFactorEnrollment struct {
+	Secret string `json:"secret"`
+	Uri    string `json:"uri"`
+	QrCode string `json:"qrCode"`
+}
+
+// RecoveryCodes показываются один раз, в базе хранятся только их хеши
+type RecoveryCodes struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+// FieldError - нарушенное правило проверки поля запроса
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+type ValidationReport struct {
+	Errors []FieldError `json:"errors"`
+}
+
+// Email - адрес для сброса пароля, пустая строка удаляет его
+type Email struct {
+	Email string `json:"email"`
+}
+
+// PasswordResetRequest - запрос письма со ссылкой сброса: имя пользователя или адрес
+type PasswordResetRequest struct {
+	Username string `json:"username,omitempty"`
+	Email    string `json:"email,omitempty"`
+}
+
+type PasswordReset struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
+
+// PasswordResetToken - токен сброса, выданный администратором
+type PasswordResetToken struct {
+	Token   string `json:"token"`
+	Expires int64  `json:"expires"`
+	Mailed  bool   `json:"mailed"` //Ссылка отправлена на адрес пользователя
+}
+
+// Identity - учётная запись провайдера OIDC, через которую пользователь может войти
+type Identity struct {
+	Provider string `json:"provider"`
+	Subject  string `json:"subject"`
+	Created  int64  `json:"created"`
+}
+
+type LegalHold struct {
+	Id        string `json:"id"`
+	LegalHold bool   `json:"legalHold"`
+}
+
 type PastePassword struct {
 	Password string `json:"password,omitempty"`
 }
@@ -0,0 +1,109 @@
+// Package audit ведёт журнал событий безопасности: входы, смена учётных данных,
+// удаление аккаунтов и вставок, действия администраторов. Таблица audit_events
+// только дополняется, изменить или удалить запись не дают триггеры БД
+package audit
+
+import (
+	"log"
+	"pasteGo/backend/db"
+	"pasteGo/backend/db/typesDB"
+	"time"
+)
+
+// Действия в журнале аудита
+const (
+	ActionLogin          = "auth.login"
+	ActionLoginFailed    = "auth.login_failed"
+	ActionLockout        = "auth.lockout"
+	ActionTwoFactorFail  = "auth.2fa_failed"
+	ActionSSOFailed      = "auth.sso_failed"
+	ActionRegister       = "user.register"
+	ActionPasswordChange = "user.password_change"
+	ActionUsernameChange = "user.username_change"
+	ActionTwoFactorOn    = "user.2fa_enable"
+	ActionTwoFactorOff   = "user.2fa_disable"
+	ActionRecoveryCodes  = "user.recovery_codes"
+	ActionIdentityLink   = "user.identity_link"
+	ActionEmailChange    = "user.email_change"
+	ActionResetRequest   = "user.password_reset_request"
+	ActionPasswordReset  = "user.password_reset"
+	ActionUserDelete     = "user.delete"
+	ActionUserPurge      = "user.purge"
+
+	ActionPasteDelete       = "paste.delete"
+	ActionPasteDeleteDenied = "paste.delete_denied"
+	ActionPasteExpire       = "paste.expire"
+	ActionPastePurge        = "paste.purge"
+	ActionLegalHold         = "paste.legal_hold"
+	ActionLegalHoldRelease  = "paste.legal_hold_release"
+	ActionPasteShare        = "paste.share"
+	ActionPasteUnshare      = "paste.unshare"
+	ActionPasteLinkCreate   = "paste.link_create"
+	ActionPasteLinkRevoke   = "paste.link_revoke"
+
+	ActionTeamCreate       = "team.create"
+	ActionTeamDelete       = "team.delete"
+	ActionTeamMemberAdd    = "team.member_add"
+	ActionTeamMemberRole   = "team.member_role"
+	ActionTeamMemberRemove = "team.member_remove"
+
+	ActionAdminGrant            = "admin.grant"
+	ActionAdminRevoke           = "admin.revoke"
+	ActionUnlock                = "admin.unlock"
+	ActionTwoFactorReset        = "admin.2fa_reset"
+	ActionResetIssue            = "admin.password_reset"
+	ActionQuotaChange           = "admin.quota_change"
+	ActionRetentionPolicyAdd    = "admin.retention_policy_add"
+	ActionRetentionPolicyDelete = "admin.retention_policy_delete"
+	ActionRetentionRun          = "admin.retention_run"
+	ActionAuditExport           = "admin.audit_export"
+)
+
+// ActorCLI - автор событий, выполненных командами pasteGo из консоли
+const ActorCLI = "cli"
+
+// ActorOIDC - автор изменений прав по группам провайдера OIDC
+const ActorOIDC = "oidc"
+
+// Event - событие аудита. Ip и UserAgent пусты для фоновых задач и консоли
+type Event struct {
+	Actor     string
+	Action    string
+	Target    string
+	Ip        string
+	UserAgent string
+	Detail    string
+}
+
+func UserTarget(id string) string {
+	return "user:" + id
+}
+
+func PasteTarget(id string) string {
+	return "paste:" + id
+}
+
+func TeamTarget(id string) string {
+	return "team:" + id
+}
+
+func PolicyTarget(id string) string {
+	return "policy:" + id
+}
+
+// Record добавляет событие в журнал. Действие к этому моменту уже выполнено,
+// поэтому ошибка записи только логируется
+func Record(DBInstance *db.DBInstance, event Event) {
+	record := typesDB.AuditEventRecord{
+		Time:      time.Now().Unix(),
+		Actor:     event.Actor,
+		Action:    event.Action,
+		Target:    event.Target,
+		Ip:        event.Ip,
+		UserAgent: event.UserAgent,
+		Detail:    event.Detail,
+	}
+	if err := DBInstance.AddAuditEventRecord(&record); err != nil {
+		log.Printf("audit %s %s: %s", event.Action, event.Target, err)
+	}
+}
@@ -0,0 +1,115 @@
+// Package auth проверяет имя и пароль при входе цепочкой бэкендов из AUTH_BACKENDS:
+// пароли в базе pasteGo (local) и LDAP/Active Directory (ldap). Пользователи внешних
+// бэкендов и провайдера OIDC получают строку users при первом входе
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"pasteGo/backend/config"
+	"pasteGo/backend/db"
+	"pasteGo/backend/db/typesDB"
+	"pasteGo/backend/validation"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrUnknownUser   = errors.New("unknown user")
+	ErrWrongPassword = errors.New("wrong password")
+	ErrNotAllowed    = errors.New("user is not allowed to log in")
+	ErrUsernameTaken = errors.New("username is taken by another account")
+)
+
+// Result - пользователь, которого подтвердил бэкенд
+type Result struct {
+	Backend string
+	User    typesDB.UserRecord
+	Created bool     //Пользователь создан при этом входе
+	Admin   *bool    //Права администратора по группам бэкенда, nil - не менять
+	Groups  []string //Группы пользователя в бэкенде, nil - бэкенд групп не знает
+}
+
+// Authenticator - бэкенд проверки пароля. ErrUnknownUser передаёт вход следующему бэкенду,
+// любая другая ошибка завершает проверку. С ErrWrongPassword Result.User может быть заполнен
+type Authenticator interface {
+	Name() string
+	Authenticate(DBInstance *db.DBInstance, username string, password string) (Result, error)
+}
+
+// Chain опрашивает бэкенды по порядку
+type Chain []Authenticator
+
+func (chain Chain) Authenticate(DBInstance *db.DBInstance, username string, password string) (Result, error) {
+	for _, backend := range chain {
+		result, err := backend.Authenticate(DBInstance, username, password)
+		if errors.Is(err, ErrUnknownUser) {
+			continue
+		}
+		result.Backend = backend.Name()
+		if err != nil {
+			return result, fmt.Errorf("%s: %w", backend.Name(), err)
+		}
+		return result, nil
+	}
+	return Result{}, ErrUnknownUser
+}
+
+var (
+	chain     Chain
+	chainOnce sync.Once
+)
+
+// GetChain собирает цепочку из AUTH_BACKENDS. С LOCAL_PASSWORDS=false бэкенд local пропускается
+func GetChain() Chain {
+	chainOnce.Do(func() {
+		for _, name := range config.AuthBackends {
+			switch name {
+			case "local":
+				if config.LocalPasswords {
+					chain = append(chain, Local{})
+				}
+			case "ldap":
+				chain = append(chain, &LDAP{config: config.LDAP})
+			}
+		}
+	})
+	return chain
+}
+
+// Provision находит пользователя, привязанного к внешней учётной записи, а если привязки нет -
+// создаёт его под именем username при create. Без create возвращается ErrUnknownUser,
+// если имя занято - ErrUsernameTaken
+func Provision(DBInstance *db.DBInstance, provider string, subject string, username string, create bool) (Result, error) {
+	userDB, exists, err := DBInstance.GetUserRecordByIdentity(provider, subject)
+	if err != nil {
+		return Result{}, err
+	}
+	if exists {
+		return Result{User: userDB}, nil
+	}
+	if !create {
+		return Result{}, ErrUnknownUser
+	}
+
+	//Пароль пустой: войти по паролю pasteGo такой пользователь не может, пока не задаст его.
+	//Правила USERNAME_* не применяются: имена выдаёт каталог, но форма Unicode та же, что у остальных
+	userDB = typesDB.UserRecord{
+		Id:       uuid.NewString(),
+		Username: validation.NormalizeUsername(username),
+	}
+	created, err := DBInstance.AddUserRecordWithIdentity(&userDB, &typesDB.IdentityRecord{
+		Provider: provider,
+		Subject:  subject,
+		Created:  time.Now().Unix(),
+	})
+	if err != nil {
+		return Result{}, err
+	}
+	if !created {
+		return Result{}, ErrUsernameTaken
+	}
+	return Result{User: userDB, Created: true}, nil
+}
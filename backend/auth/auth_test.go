@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"errors"
+	"os"
+	"pasteGo/backend/db"
+	"pasteGo/backend/db/typesDB"
+	"strings"
+	"testing"
+)
+
+func openTestDB(t *testing.T) *db.DBInstance {
+	t.Helper()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		db.CloseDB()
+		os.Chdir(wd)
+	})
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := DBInstance.Init(); err != nil {
+		t.Fatal(err)
+	}
+	return DBInstance
+}
+
+func TestChain(t *testing.T) {
+	DBInstance := openTestDB(t)
+	ldapBackend, server := newTestLDAP(t)
+	chain := Chain{Local{}, ldapBackend}
+
+	for _, user := range []typesDB.UserRecord{
+		{Id: "local-id", Username: "carol", Password: HashPassword("carol-secret")},
+		//Локальный пользователь с тем же именем, что и в каталоге: пароль проверяет local
+		{Id: "bob-local-id", Username: "bob", Password: HashPassword("local-secret")},
+	} {
+		if _, err := DBInstance.AddUserRecord(&user); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		username string
+		password string
+		backend  string
+		err      error
+		ldap     bool //Дошёл ли вход до LDAP
+	}{
+		{"local user", "carol", "carol-secret", "local", nil, false},
+		{"local wrong password stops the chain", "carol", "alice-secret", "local", ErrWrongPassword, false},
+		{"local user shadows the directory", "bob", "local-secret", "local", nil, false},
+		{"directory password of a local user", "bob", "bob-secret", "local", ErrWrongPassword, false},
+		{"falls through to ldap", "alice", "alice-secret", "ldap", nil, true},
+		//Пароль пользователя, созданного при входе через LDAP, пуст: local его пропускает
+		{"provisioned user falls through again", "Alice", "alice-secret", "ldap", nil, true},
+		{"provisioned user with an empty password", "Alice", "", "ldap", ErrWrongPassword, false},
+		{"ldap wrong password", "alice", "wrong", "ldap", ErrWrongPassword, true},
+		{"unknown everywhere", "dave", "dave-secret", "", ErrUnknownUser, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := len(server.Binds())
+			result, err := chain.Authenticate(DBInstance, tt.username, tt.password)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if result.Backend != tt.backend {
+				t.Errorf("backend = %q, want %q", result.Backend, tt.backend)
+			}
+			if reached := len(server.Binds()) > before; reached != tt.ldap {
+				t.Errorf("ldap reached: %t, want %t", reached, tt.ldap)
+			}
+		})
+	}
+
+	//Недоступный каталог - ошибка, а не отказ во входе
+	server.Close()
+	ldapBackend.config.Timeout = 0
+	_, err := chain.Authenticate(DBInstance, "erin", "erin-secret")
+	if err == nil || errors.Is(err, ErrUnknownUser) || errors.Is(err, ErrWrongPassword) || !strings.HasPrefix(err.Error(), "ldap: ") {
+		t.Errorf("unreachable directory: err = %v", err)
+	}
+	if _, err := chain.Authenticate(DBInstance, "carol", "carol-secret"); err != nil {
+		t.Errorf("local login with the directory down: %v", err)
+	}
+}
+
+func TestChainEmpty(t *testing.T) {
+	if _, err := (Chain{}).Authenticate(nil, "alice", "alice-secret"); !errors.Is(err, ErrUnknownUser) {
+		t.Errorf("err = %v, want ErrUnknownUser", err)
+	}
+}
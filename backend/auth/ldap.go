@@ -0,0 +1,123 @@
+package auth
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"pasteGo/backend/config"
+	"pasteGo/backend/db"
+	"strings"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+// ProviderLDAP - provider привязок identities для пользователей LDAP
+const ProviderLDAP = "ldap"
+
+// LDAP ищет пользователя сервисной учётной записью и проверяет пароль bind'ом от его имени
+type LDAP struct {
+	config config.LDAPConfig
+}
+
+func (backend *LDAP) Name() string {
+	return "ldap"
+}
+
+func (backend *LDAP) Authenticate(DBInstance *db.DBInstance, username string, password string) (Result, error) {
+	//Bind с пустым паролем сервер считает анонимным и принимает
+	if username == "" || password == "" {
+		return Result{}, ErrWrongPassword
+	}
+
+	conn, err := backend.dial()
+	if err != nil {
+		return Result{}, err
+	}
+	defer conn.Close()
+
+	if backend.config.BindDN != "" {
+		err = conn.Bind(backend.config.BindDN, backend.config.BindPassword)
+	} else {
+		err = conn.UnauthenticatedBind("")
+	}
+	if err != nil {
+		return Result{}, fmt.Errorf("service bind: %w", err)
+	}
+
+	search := ldap.NewSearchRequest(
+		backend.config.BaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 2, int(backend.config.Timeout.Seconds()), false,
+		strings.ReplaceAll(backend.config.UserFilter, "%s", ldap.EscapeFilter(username)),
+		[]string{backend.config.UsernameAttribute, backend.config.GroupAttribute},
+		nil,
+	)
+	found, err := conn.Search(search)
+	if err != nil {
+		return Result{}, fmt.Errorf("search: %w", err)
+	}
+	switch len(found.Entries) {
+	case 0:
+		return Result{}, ErrUnknownUser
+	case 1:
+	default:
+		return Result{}, fmt.Errorf("filter matches %d entries for %q", len(found.Entries), username)
+	}
+	entry := found.Entries[0]
+
+	if err := conn.Bind(entry.DN, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return Result{}, ErrWrongPassword
+		}
+		return Result{}, fmt.Errorf("user bind: %w", err)
+	}
+
+	groups := entry.GetAttributeValues(backend.config.GroupAttribute)
+	if backend.config.RequiredGroup != "" && !hasGroup(groups, backend.config.RequiredGroup) {
+		return Result{}, ErrNotAllowed
+	}
+	account := entry.GetAttributeValue(backend.config.UsernameAttribute)
+	if account == "" {
+		return Result{}, fmt.Errorf("%s has no %s attribute", entry.DN, backend.config.UsernameAttribute)
+	}
+
+	result, err := Provision(DBInstance, ProviderLDAP, strings.ToLower(account), account, true)
+	if err != nil {
+		return Result{}, err
+	}
+	if backend.config.AdminGroup != "" {
+		admin := hasGroup(groups, backend.config.AdminGroup)
+		result.Admin = &admin
+	}
+	result.Groups = append([]string{}, groups...)
+	return result, nil
+}
+
+func (backend *LDAP) dial() (*ldap.Conn, error) {
+	conn, err := ldap.DialURL(backend.config.URL, ldap.DialWithDialer(&net.Dialer{Timeout: backend.config.Timeout}))
+	if err != nil {
+		return nil, err
+	}
+	conn.SetTimeout(backend.config.Timeout)
+	if backend.config.StartTLS {
+		parsed, err := url.Parse(backend.config.URL)
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+		if err := conn.StartTLS(&tls.Config{ServerName: parsed.Hostname()}); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("start tls: %w", err)
+		}
+	}
+	return conn, nil
+}
+
+// hasGroup сравнивает DN групп без учёта регистра, как это делает AD
+func hasGroup(groups []string, group string) bool {
+	for _, member := range groups {
+		if strings.EqualFold(member, group) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,207 @@
+package auth
+
+import (
+	"errors"
+	"pasteGo/backend/auth/ldaptest"
+	"pasteGo/backend/config"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	testServiceDN       = "cn=pastego,ou=services,dc=example,dc=org"
+	testServicePassword = "service-secret"
+	testDevelopers      = "cn=developers,ou=groups,dc=example,dc=org"
+	testAdmins          = "cn=admins,ou=groups,dc=example,dc=org"
+)
+
+func testEntries() []ldaptest.Entry {
+	return []ldaptest.Entry{
+		{
+			DN:       "uid=alice,ou=people,dc=example,dc=org",
+			Password: "alice-secret",
+			Attributes: map[string][]string{
+				"objectClass": {"person"},
+				"uid":         {"Alice"},
+				"memberOf":    {testDevelopers, testAdmins},
+			},
+		},
+		{
+			DN:       "uid=bob,ou=people,dc=example,dc=org",
+			Password: "bob-secret",
+			Attributes: map[string][]string{
+				"objectClass": {"person"},
+				"uid":         {"bob"},
+			},
+		},
+		//Имя из спецсимволов фильтра: найти её можно только по точному совпадению
+		{
+			DN:       "uid=star,ou=people,dc=example,dc=org",
+			Password: "star-secret",
+			Attributes: map[string][]string{
+				"objectClass": {"person"},
+				"uid":         {"*)(uid=\\"},
+			},
+		},
+	}
+}
+
+func newTestLDAP(t *testing.T) (*LDAP, *ldaptest.Server) {
+	t.Helper()
+	server, err := ldaptest.NewServer(testServiceDN, testServicePassword, testEntries()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { server.Close() })
+
+	return &LDAP{config: config.LDAPConfig{
+		URL:               server.URL,
+		BindDN:            testServiceDN,
+		BindPassword:      testServicePassword,
+		BaseDN:            "dc=example,dc=org",
+		UserFilter:        "(&(objectClass=person)(uid=%s))",
+		UsernameAttribute: "uid",
+		GroupAttribute:    "memberOf",
+		Timeout:           5 * time.Second,
+	}}, server
+}
+
+func TestLDAPAuthenticate(t *testing.T) {
+	DBInstance := openTestDB(t)
+	backend, server := newTestLDAP(t)
+	backend.config.AdminGroup = testAdmins
+
+	result, err := backend.Authenticate(DBInstance, "alice", "alice-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Created || result.User.Username != "Alice" {
+		t.Errorf("first login: created %t, user %+v", result.Created, result.User)
+	}
+	if result.Admin == nil || !*result.Admin {
+		t.Errorf("member of the admin group: Admin = %v", result.Admin)
+	}
+	if !slices.Equal(result.Groups, []string{testDevelopers, testAdmins}) {
+		t.Errorf("groups = %q", result.Groups)
+	}
+	//Сначала сервисная учётная запись, затем пароль проверяется bind'ом от имени найденной записи
+	want := []ldaptest.Bind{
+		{DN: testServiceDN, Password: testServicePassword},
+		{DN: "uid=alice,ou=people,dc=example,dc=org", Password: "alice-secret"},
+	}
+	if binds := server.Binds(); !slices.Equal(binds, want) {
+		t.Errorf("binds = %+v, want %+v", binds, want)
+	}
+
+	again, err := backend.Authenticate(DBInstance, "alice", "alice-secret")
+	if err != nil || again.Created || again.User.Id != result.User.Id {
+		t.Errorf("second login: created %t, user %+v, %v", again.Created, again.User, err)
+	}
+	userDB, exists, err := DBInstance.GetUserRecordByIdentity(ProviderLDAP, "alice")
+	if err != nil || !exists || userDB.Id != result.User.Id {
+		t.Errorf("identity ldap/alice = %+v, %t, %v", userDB, exists, err)
+	}
+
+	bob, err := backend.Authenticate(DBInstance, "bob", "bob-secret")
+	if err != nil || bob.Admin == nil || *bob.Admin || len(bob.Groups) != 0 {
+		t.Errorf("bob = %+v, %v", bob, err)
+	}
+}
+
+func TestLDAPAuthenticateErrors(t *testing.T) {
+	DBInstance := openTestDB(t)
+	backend, _ := newTestLDAP(t)
+
+	if _, err := backend.Authenticate(DBInstance, "alice", "wrong"); !errors.Is(err, ErrWrongPassword) {
+		t.Errorf("wrong password: err = %v", err)
+	}
+	if _, err := backend.Authenticate(DBInstance, "carol", "carol-secret"); !errors.Is(err, ErrUnknownUser) {
+		t.Errorf("unknown user: err = %v", err)
+	}
+
+	backend.config.RequiredGroup = testDevelopers
+	if _, err := backend.Authenticate(DBInstance, "bob", "bob-secret"); !errors.Is(err, ErrNotAllowed) {
+		t.Errorf("user outside the required group: err = %v", err)
+	}
+	if _, err := backend.Authenticate(DBInstance, "alice", "alice-secret"); err != nil {
+		t.Errorf("user in the required group: %v", err)
+	}
+
+	backend.config.BindPassword = "wrong"
+	if _, err := backend.Authenticate(DBInstance, "alice", "alice-secret"); err == nil || errors.Is(err, ErrWrongPassword) {
+		t.Errorf("broken service account: err = %v", err)
+	}
+}
+
+func TestLDAPFilterEscaping(t *testing.T) {
+	DBInstance := openTestDB(t)
+	backend, server := newTestLDAP(t)
+
+	tests := []struct {
+		username string
+		filter   string
+	}{
+		//Без экранирования * нашёл бы всех, а )( добавил бы в фильтр своё условие
+		{"*", `(&(objectClass=person)(uid=\2a))`},
+		{"al*", `(&(objectClass=person)(uid=al\2a))`},
+		{"*)(objectClass=*", `(&(objectClass=person)(uid=\2a\29\28objectClass=\2a))`},
+		{"bob)(|(uid=*", `(&(objectClass=person)(uid=bob\29\28|\28uid=\2a))`},
+		{"\\2a", `(&(objectClass=person)(uid=\5c2a))`},
+		{"nul\x00", `(&(objectClass=person)(uid=nul\00))`},
+	}
+	for _, tt := range tests {
+		before := len(server.Filters())
+		if _, err := backend.Authenticate(DBInstance, tt.username, "alice-secret"); !errors.Is(err, ErrUnknownUser) {
+			t.Errorf("Authenticate(%q): err = %v, want ErrUnknownUser", tt.username, err)
+		}
+		filters := server.Filters()[before:]
+		if len(filters) != 1 || filters[0] != tt.filter {
+			t.Errorf("Authenticate(%q) searched %q, want %q", tt.username, filters, tt.filter)
+		}
+	}
+	for _, bind := range server.Binds() {
+		if bind.DN != testServiceDN {
+			t.Errorf("user bind as %s after an injected filter", bind.DN)
+		}
+	}
+
+	//Спецсимволы в настоящем имени не мешают его найти
+	result, err := backend.Authenticate(DBInstance, "*)(uid=\\", "star-secret")
+	if err != nil || result.User.Username != "*)(uid=\\" {
+		t.Errorf("user with filter characters in the name: %+v, %v", result.User, err)
+	}
+}
+
+func TestLDAPEmptyPassword(t *testing.T) {
+	DBInstance := openTestDB(t)
+	backend, server := newTestLDAP(t)
+
+	//Сервер принял бы такой bind как анонимный, поэтому до сервера он дойти не должен
+	for _, credentials := range [][2]string{{"alice", ""}, {"", "alice-secret"}, {"", ""}} {
+		if _, err := backend.Authenticate(DBInstance, credentials[0], credentials[1]); !errors.Is(err, ErrWrongPassword) {
+			t.Errorf("Authenticate(%q, %q): err = %v, want ErrWrongPassword", credentials[0], credentials[1], err)
+		}
+	}
+	if binds := server.Binds(); len(binds) != 0 {
+		t.Errorf("binds = %+v, want none", binds)
+	}
+	if _, exists, _ := DBInstance.GetUserRecordByUsername("Alice"); exists {
+		t.Error("user provisioned by an empty password")
+	}
+
+	//Анонимный поиск без сервисной учётной записи - единственный bind без пароля
+	backend.config.BindDN, backend.config.BindPassword = "", ""
+	if _, err := backend.Authenticate(DBInstance, "alice", "alice-secret"); err != nil {
+		t.Fatal(err)
+	}
+	for _, bind := range server.Binds() {
+		if bind.Password == "" && bind.DN != "" {
+			t.Errorf("unauthenticated bind as %s", bind.DN)
+		}
+	}
+	if binds := server.Binds(); len(binds) != 2 || !strings.HasPrefix(binds[1].DN, "uid=alice,") {
+		t.Errorf("binds = %+v", binds)
+	}
+}
@@ -0,0 +1,246 @@
+// Package ldaptest - LDAP сервер в памяти для тестов бэкенда ldap: simple bind и поиск
+// по равенству одного атрибута. Каждый запрос запоминается, чтобы тест мог его проверить
+package ldaptest
+
+import (
+	"errors"
+	"net"
+	"strings"
+	"sync"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+)
+
+// Entry - запись каталога
+type Entry struct {
+	DN         string
+	Password   string //Пароль для bind от имени записи
+	Attributes map[string][]string
+}
+
+// Bind - принятый сервером bind
+type Bind struct {
+	DN       string
+	Password string
+}
+
+type Server struct {
+	URL string
+
+	listener net.Listener
+	mutex    sync.Mutex
+	entries  []Entry
+	binds    []Bind
+	filters  []string
+}
+
+// NewServer запускает сервер на свободном порту localhost. Bind пользователем serviceDN с паролем
+// servicePassword и анонимный bind принимаются как сервисные
+func NewServer(serviceDN string, servicePassword string, entries ...Entry) (*Server, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	server := &Server{
+		URL:      "ldap://" + listener.Addr().String(),
+		listener: listener,
+		entries:  append([]Entry{{DN: serviceDN, Password: servicePassword}}, entries...),
+	}
+	go server.serve()
+	return server, nil
+}
+
+func (server *Server) Close() error {
+	return server.listener.Close()
+}
+
+// Update заменяет запись с тем же DN или добавляет новую
+func (server *Server) Update(entry Entry) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	for i := range server.entries {
+		if strings.EqualFold(server.entries[i].DN, entry.DN) {
+			server.entries[i] = entry
+			return
+		}
+	}
+	server.entries = append(server.entries, entry)
+}
+
+// Binds возвращает все попытки bind в порядке поступления
+func (server *Server) Binds() []Bind {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	return append([]Bind{}, server.binds...)
+}
+
+// Filters возвращает фильтры всех поисков в строковом виде
+func (server *Server) Filters() []string {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	return append([]string{}, server.filters...)
+}
+
+func (server *Server) serve() {
+	for {
+		conn, err := server.listener.Accept()
+		if err != nil {
+			return
+		}
+		go server.handle(conn)
+	}
+}
+
+func (server *Server) handle(conn net.Conn) {
+	defer conn.Close()
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil {
+			return
+		}
+		if len(packet.Children) < 2 {
+			return
+		}
+		id, _ := packet.Children[0].Value.(int64)
+		request := packet.Children[1]
+
+		var responses []*ber.Packet
+		switch request.Tag {
+		case ldap.ApplicationBindRequest:
+			responses = []*ber.Packet{server.bind(request)}
+		case ldap.ApplicationSearchRequest:
+			responses = server.search(request)
+		default:
+			//Unbind и всё, что сервер не умеет, закрывают соединение
+			return
+		}
+		for _, response := range responses {
+			envelope := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+			envelope.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, id, "Message ID"))
+			envelope.AppendChild(response)
+			if _, err := conn.Write(envelope.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (server *Server) bind(request *ber.Packet) *ber.Packet {
+	if len(request.Children) < 3 {
+		return result(ldap.ApplicationBindResponse, ldap.LDAPResultProtocolError)
+	}
+	dn, _ := request.Children[1].Value.(string)
+	password := request.Children[2].Data.String()
+
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.binds = append(server.binds, Bind{DN: dn, Password: password})
+	//Как и настоящий сервер по RFC 4513, bind с пустым паролем считается анонимным, даже с именем
+	if password == "" {
+		return result(ldap.ApplicationBindResponse, ldap.LDAPResultSuccess)
+	}
+	for _, entry := range server.entries {
+		if strings.EqualFold(entry.DN, dn) && entry.Password == password {
+			return result(ldap.ApplicationBindResponse, ldap.LDAPResultSuccess)
+		}
+	}
+	return result(ldap.ApplicationBindResponse, ldap.LDAPResultInvalidCredentials)
+}
+
+func (server *Server) search(request *ber.Packet) []*ber.Packet {
+	if len(request.Children) < 8 {
+		return []*ber.Packet{result(ldap.ApplicationSearchResultDone, ldap.LDAPResultProtocolError)}
+	}
+	filter, err := ldap.DecompileFilter(request.Children[6])
+	if err != nil {
+		return []*ber.Packet{result(ldap.ApplicationSearchResultDone, ldap.LDAPResultProtocolError)}
+	}
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.filters = append(server.filters, filter)
+
+	var responses []*ber.Packet
+	for _, entry := range server.entries {
+		matched, err := matches(request.Children[6], entry)
+		if err != nil {
+			return []*ber.Packet{result(ldap.ApplicationSearchResultDone, ldap.LDAPResultUnwillingToPerform)}
+		}
+		if !matched {
+			continue
+		}
+		found := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldap.ApplicationSearchResultEntry, nil, "Search Result Entry")
+		found.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, entry.DN, "DN"))
+		attributes := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attributes")
+		for name, values := range entry.Attributes {
+			attribute := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attribute")
+			attribute.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "Type"))
+			set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+			for _, value := range values {
+				set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, value, "Value"))
+			}
+			attribute.AppendChild(set)
+			attributes.AppendChild(attribute)
+		}
+		found.AppendChild(attributes)
+		responses = append(responses, found)
+	}
+	return append(responses, result(ldap.ApplicationSearchResultDone, ldap.LDAPResultSuccess))
+}
+
+var errUnsupported = errors.New("unsupported filter")
+
+// matches проверяет запись фильтром из and, or, not, равенства и присутствия атрибута
+func matches(filter *ber.Packet, entry Entry) (bool, error) {
+	switch filter.Tag {
+	case ldap.FilterAnd, ldap.FilterOr:
+		for _, child := range filter.Children {
+			matched, err := matches(child, entry)
+			if err != nil {
+				return false, err
+			}
+			if matched == (filter.Tag == ldap.FilterOr) {
+				return matched, nil
+			}
+		}
+		return filter.Tag == ldap.FilterAnd, nil
+	case ldap.FilterNot:
+		if len(filter.Children) != 1 {
+			return false, errUnsupported
+		}
+		matched, err := matches(filter.Children[0], entry)
+		return !matched, err
+	case ldap.FilterEqualityMatch:
+		if len(filter.Children) != 2 {
+			return false, errUnsupported
+		}
+		name := filter.Children[0].Data.String()
+		value := filter.Children[1].Data.String()
+		for _, candidate := range attributeValues(entry, name) {
+			if strings.EqualFold(candidate, value) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case ldap.FilterPresent:
+		return len(attributeValues(entry, filter.Data.String())) > 0, nil
+	}
+	return false, errUnsupported
+}
+
+func attributeValues(entry Entry, name string) []string {
+	for attribute, values := range entry.Attributes {
+		if strings.EqualFold(attribute, name) {
+			return values
+		}
+	}
+	return nil
+}
+
+func result(tag ber.Tag, code uint16) *ber.Packet {
+	packet := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Result")
+	packet.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(code), "Result Code"))
+	packet.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	packet.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Diagnostic Message"))
+	return packet
+}
@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"log"
+	"pasteGo/backend/db"
+)
+
+// Local проверяет пароль, хранящийся в базе pasteGo
+type Local struct{}
+
+func (Local) Name() string {
+	return "local"
+}
+
+func (Local) Authenticate(DBInstance *db.DBInstance, username string, password string) (Result, error) {
+	userDB, exists, err := DBInstance.GetUserRecordByUsername(username)
+	if err != nil {
+		return Result{}, err
+	}
+	//Пустой пароль у пользователей, созданных через OIDC или LDAP: их проверяет другой бэкенд
+	if !exists || userDB.Password == "" {
+		return Result{}, ErrUnknownUser
+	}
+	ok, rehash := CheckPassword(userDB.Password, password)
+	if !ok {
+		return Result{User: userDB}, ErrWrongPassword
+	}
+	//Старый хеш заменяется, пока пароль известен. Неудача не мешает входу: попробуем в следующий раз
+	if rehash {
+		newHash := HashPassword(password)
+		if err := DBInstance.RehashUserPassword(userDB.Id, userDB.Password, newHash); err != nil {
+			log.Printf("rehash password of %s: %s", userDB.Id, err)
+		} else {
+			userDB.Password = newHash
+		}
+	}
+	return Result{User: userDB}, nil
+}
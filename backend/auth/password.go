@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// Параметры argon2id для новых хешей. Хеши с другими параметрами принимаются и заменяются при входе
+const (
+	argonTime    uint32 = 2
+	argonMemory  uint32 = 19 * 1024 //КиБ
+	argonThreads uint8  = 1
+	argonKeyLen  uint32 = 32
+	argonSaltLen        = 16
+)
+
+// HashPassword - вид, в котором пароль хранится в users.password и pastes.password:
+// argon2id со случайной солью в формате PHC
+func HashPassword(password string) string {
+	salt := make([]byte, argonSaltLen)
+	rand.Read(salt)
+	key := argon2.IDKey([]byte(password), salt, argonTime, argonMemory, argonThreads, argonKeyLen)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, argonMemory, argonTime, argonThreads,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key))
+}
+
+// CheckPassword сравнивает пароль с хешем из базы. rehash - хеш записан в старом виде
+// (SHA-256 без соли или argon2id с прежними параметрами) и его стоит заменить на HashPassword
+func CheckPassword(hash string, password string) (ok bool, rehash bool) {
+	if !strings.HasPrefix(hash, "$argon2id$") {
+		//Хеши до argon2id: hex SHA-256 без соли
+		if len(hash) != sha256.Size*2 {
+			return false, false
+		}
+		sum := sha256.Sum256([]byte(password))
+		ok = subtle.ConstantTimeCompare([]byte(hash), []byte(hex.EncodeToString(sum[:]))) == 1
+		return ok, ok
+	}
+
+	var version int
+	var memory, time uint32
+	var threads uint8
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 {
+		return false, false
+	}
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return false, false
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &memory, &time, &threads); err != nil || time == 0 || threads == 0 {
+		return false, false
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return false, false
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return false, false
+	}
+
+	candidate := argon2.IDKey([]byte(password), salt, time, memory, threads, uint32(len(key)))
+	if subtle.ConstantTimeCompare(key, candidate) != 1 {
+		return false, false
+	}
+	return true, memory != argonMemory || time != argonTime || threads != argonThreads || uint32(len(key)) != argonKeyLen || len(salt) != argonSaltLen
+}
@@ -0,0 +1,114 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"pasteGo/backend/db/typesDB"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/argon2"
+)
+
+func legacyHash(password string) string {
+	sum := sha256.Sum256([]byte(password))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestHashPassword(t *testing.T) {
+	hash := HashPassword("correct horse")
+	if !strings.HasPrefix(hash, "$argon2id$v=19$m=19456,t=2,p=1$") {
+		t.Errorf("hash = %q", hash)
+	}
+	//Соль случайная: одинаковые пароли дают разные хеши
+	if other := HashPassword("correct horse"); other == hash {
+		t.Error("two hashes of the same password are equal")
+	}
+	if ok, rehash := CheckPassword(hash, "correct horse"); !ok || rehash {
+		t.Errorf("CheckPassword = %t, %t, want true, false", ok, rehash)
+	}
+	if ok, _ := CheckPassword(hash, "correct horse "); ok {
+		t.Error("wrong password accepted")
+	}
+	if ok, _ := CheckPassword(HashPassword(""), ""); !ok {
+		t.Error("empty password does not match its own hash")
+	}
+}
+
+func TestCheckPasswordLegacy(t *testing.T) {
+	if ok, rehash := CheckPassword(legacyHash("secret"), "secret"); !ok || !rehash {
+		t.Errorf("SHA-256 hash: %t, %t, want true, true", ok, rehash)
+	}
+	if ok, _ := CheckPassword(legacyHash("secret"), "Secret"); ok {
+		t.Error("SHA-256 hash accepted a wrong password")
+	}
+	if ok, rehash := CheckPassword(strings.ToUpper(legacyHash("secret")), "secret"); ok || rehash {
+		t.Errorf("upper case SHA-256 hash: %t, %t", ok, rehash)
+	}
+}
+
+func TestCheckPasswordRejects(t *testing.T) {
+	valid := HashPassword("secret")
+	parts := strings.Split(valid, "$")
+	for _, hash := range []string{
+		"",
+		"secret",
+		"$argon2id$",
+		"$argon2i$v=19$m=19456,t=2,p=1$" + parts[4] + "$" + parts[5],
+		"$argon2id$v=16$m=19456,t=2,p=1$" + parts[4] + "$" + parts[5],
+		"$argon2id$v=19$m=19456,t=0,p=1$" + parts[4] + "$" + parts[5],
+		"$argon2id$v=19$m=19456,t=2,p=0$" + parts[4] + "$" + parts[5],
+		"$argon2id$v=19$m=19456,t=2,p=1$" + parts[4] + "$",
+		"$argon2id$v=19$m=19456,t=2,p=1$!!$" + parts[5],
+		valid + "$",
+	} {
+		if ok, _ := CheckPassword(hash, "secret"); ok {
+			t.Errorf("CheckPassword(%q) accepted the password", hash)
+		}
+	}
+}
+
+func TestCheckPasswordOldParameters(t *testing.T) {
+	//Хеш с прежними параметрами проверяется по своим параметрам и требует замены
+	salt := []byte("0123456789abcdef")
+	key := argon2.IDKey([]byte("secret"), salt, 1, 8*1024, 1, 32)
+	hash := "$argon2id$v=19$m=8192,t=1,p=1$" + base64.RawStdEncoding.EncodeToString(salt) + "$" + base64.RawStdEncoding.EncodeToString(key)
+	if ok, rehash := CheckPassword(hash, "secret"); !ok || !rehash {
+		t.Errorf("hash with m=8192,t=1: %t, %t, want true, true", ok, rehash)
+	}
+	//Параметры входят в проверку: подменить их в хеше нельзя
+	if ok, _ := CheckPassword(strings.Replace(hash, "t=1", "t=2", 1), "secret"); ok {
+		t.Error("hash with changed parameters accepted the password")
+	}
+}
+
+func TestLocalRehashesLegacyPassword(t *testing.T) {
+	DBInstance := openTestDB(t)
+	if _, err := DBInstance.AddUserRecord(&typesDB.UserRecord{Id: "carol-id", Username: "carol", Password: legacyHash("carol-secret")}); err != nil {
+		t.Fatal(err)
+	}
+
+	//Неверный пароль хеш не трогает
+	if _, err := (Local{}).Authenticate(DBInstance, "carol", "wrong"); err == nil {
+		t.Fatal("wrong password accepted")
+	}
+	if userDB, _, _ := DBInstance.GetUserRecordByUsername("carol"); userDB.Password != legacyHash("carol-secret") {
+		t.Errorf("hash changed after a wrong password: %q", userDB.Password)
+	}
+
+	result, err := (Local{}).Authenticate(DBInstance, "carol", "carol-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	userDB, _, err := DBInstance.GetUserRecordByUsername("carol")
+	if err != nil || !strings.HasPrefix(userDB.Password, "$argon2id$") || userDB.Password != result.User.Password {
+		t.Fatalf("stored hash after login = %q, %v", userDB.Password, err)
+	}
+	if _, err := (Local{}).Authenticate(DBInstance, "carol", "carol-secret"); err != nil {
+		t.Errorf("login with the new hash: %v", err)
+	}
+	if again, _, _ := DBInstance.GetUserRecordByUsername("carol"); again.Password != userDB.Password {
+		t.Error("current hash was replaced again")
+	}
+}
@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"pasteGo/backend/audit"
+	"pasteGo/backend/config"
+	"pasteGo/backend/db"
+	"pasteGo/backend/importer"
+	"pasteGo/backend/secrets"
+)
+
+var ErrUsage = errors.New("usage:\n" +
+	"  pasteGo import -format <" + strings.Join(importer.Formats(), "|") + "> -user <username> [-public] <path>\n" +
+	"  pasteGo admin -grant <username> | -revoke <username>")
+
+// Run выполняет административную команду вместо запуска сервера
+func Run(args []string) error {
+	switch args[0] {
+	case "import":
+		return runImport(args[1:])
+	case "admin":
+		return runAdmin(args[1:])
+	default:
+		return ErrUsage
+	}
+}
+
+func runImport(args []string) error {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	format := flags.String("format", "", "source format: "+strings.Join(importer.Formats(), ", "))
+	username := flags.String("user", "", "owner of the imported pastes")
+	public := flags.Bool("public", false, "make pastes public when the source has no visibility")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *format == "" || *username == "" || flags.NArg() != 1 {
+		return ErrUsage
+	}
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		return err
+	}
+	userDB, exists, err := DBInstance.GetUserRecordByUsername(*username)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("user %q not found", *username)
+	}
+
+	fsys, err := importer.OpenPath(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	entries, err := importer.Parse(*format, fsys, importer.Options{Public: *public})
+	if err != nil {
+		return err
+	}
+	for i := range entries {
+		if config.MaxPasteSize >= 0 && entries[i].HasContent() && entries[i].Size() > config.MaxPasteSize {
+			return fmt.Errorf("%q is larger than MAX_PASTE_SIZE, nothing is imported", entries[i].Title)
+		}
+	}
+	//SECRET_SCAN_MODE действует так же, как для вставок через API
+	for i := range entries {
+		if config.SecretScanMode == config.SecretScanOff || !entries[i].HasContent() {
+			continue
+		}
+		findings := secrets.Scan("", entries[i].Text)
+		for _, file := range entries[i].Files {
+			findings = append(findings, secrets.Scan(file.Name, file.Text)...)
+		}
+		for _, finding := range findings {
+			fmt.Fprintf(os.Stderr, "%s: %s %s:%d %s\n", entries[i].Title, finding.Description, finding.File, finding.StartLine, finding.Redacted)
+		}
+		if len(findings) == 0 || !entries[i].Public {
+			continue
+		}
+		switch config.SecretScanMode {
+		case config.SecretScanPrivate:
+			entries[i].Public = false
+		case config.SecretScanReject:
+			return fmt.Errorf("%q contains secrets, nothing is imported", entries[i].Title)
+		}
+	}
+
+	records, err := importer.Save(DBInstance, userDB.Id, entries)
+	for i := range records {
+		fmt.Fprintf(os.Stdout, "%s\t%s\n", records[i].Id, records[i].Title)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stdout, "imported %d of %d pastes\n", len(records), len(entries))
+	return nil
+}
+
+func runAdmin(args []string) error {
+	flags := flag.NewFlagSet("admin", flag.ContinueOnError)
+	grant := flags.String("grant", "", "give administrator rights to the user")
+	revoke := flags.String("revoke", "", "take administrator rights from the user")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if (*grant == "") == (*revoke == "") {
+		return ErrUsage
+	}
+
+	username, admin := *grant, true
+	if *revoke != "" {
+		username, admin = *revoke, false
+	}
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		return err
+	}
+	userDB, exists, err := DBInstance.GetUserRecordByUsername(username)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("user %q not found", username)
+	}
+	if err := DBInstance.SetUserAdmin(userDB.Id, admin); err != nil {
+		return err
+	}
+	action := audit.ActionAdminRevoke
+	if admin {
+		action = audit.ActionAdminGrant
+	}
+	audit.Record(DBInstance, audit.Event{
+		Actor:  audit.ActorCLI,
+		Action: action,
+		Target: audit.UserTarget(userDB.Id),
+		Detail: username,
+	})
+	fmt.Fprintf(os.Stdout, "%s: admin=%t\n", username, admin)
+	return nil
+}
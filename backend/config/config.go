@@ -0,0 +1,409 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Режимы SECRET_SCAN_MODE
+const (
+	SecretScanOff     = "off"     //Не проверять
+	SecretScanWarn    = "warn"    //Только предупреждения в ответе
+	SecretScanPrivate = "private" //Публичная вставка с секретом становится приватной
+	SecretScanReject  = "reject"  //Публичная вставка с секретом не сохраняется
+)
+
+// Настройки читаются из окружения (.env) один раз при старте
+var (
+	BlobStore               = "local"
+	BlobDir                 = "data/blobs"
+	MaxAttachmentSize int64 = 10 << 20
+
+	//Отрицательное значение лимита - без ограничений
+	MaxPasteSize      int64 = 1 << 20
+	DefaultMaxPastes  int64 = 1000
+	DefaultMaxStorage int64 = 100 << 20
+
+	//ISO-8601 длительность (P90D), пусто - без ограничения
+	MaxPasteLifetime = ""
+
+	//Период фоновой проверки политик хранения, 0 - не запускать
+	RetentionInterval = time.Hour
+
+	//Попытки входа и ввода пароля вставки на ключ (IP, имя, вставка): запас и пополнение в минуту.
+	//После LockoutThreshold неудач подряд ключ блокируется на LockoutBase, каждая следующая
+	//неудача удваивает срок до LockoutMax
+	RateLimitStore           = "memory"
+	RateLimitBurst     int64 = 10
+	RateLimitPerMinute int64 = 10
+	LockoutThreshold   int64 = 5
+	LockoutBase              = 30 * time.Second
+	LockoutMax               = time.Hour
+
+	//Адреса прокси, которым можно верить в X-Forwarded-For. Без них IP клиента - адрес соединения,
+	//иначе ограничение попыток обходится подменой заголовка
+	TrustedProxies []string
+
+	//Название сервиса в приложении-аутентификаторе
+	TotpIssuer = "pasteGo"
+
+	//false - пароли, хранящиеся в pasteGo, не принимаются, регистрация закрыта
+	LocalPasswords = true
+
+	//Бэкенды проверки пароля при входе, по порядку: local, ldap
+	AuthBackends = []string{"local"}
+
+	//Имя пользователя после нормализации NFKC: длина в символах, допустимые символы
+	//(регулярное выражение) и имена, которые нельзя занять. Регистр при сравнении не важен
+	UsernameMinLength int64 = 3
+	UsernameMaxLength int64 = 32
+	UsernamePattern         = `^[\p{L}\p{N}][\p{L}\p{N}._-]*$`
+	ReservedUsernames       = []string{"admin", "administrator", "root", "system", "support", "security",
+		"api", "rest", "auth", "login", "logout", "registration", "user", "users", "paste", "pastes",
+		"profile", "render", "oidc", "ldap", "cli", "deleted"}
+
+	//Пароль: длина в символах, сколько классов символов (строчные, заглавные, цифры, прочие)
+	//в нём должно быть и файл SHA-1 утёкших паролей, пусто - не проверять
+	PasswordMinLength  int64 = 8
+	PasswordMaxLength  int64 = 128
+	PasswordMinClasses int64 = 1
+	PasswordBreachList       = ""
+
+	//Срок действия токена сброса пароля и адрес страницы сброса, к которому дописывается ?token=
+	PasswordResetTTL = time.Hour
+	PasswordResetURL = ""
+
+	//Отправка писем: none, log, file, smtp
+	Mail = MailConfig{
+		Transport: "none",
+		File:      "data/mail.jsonl",
+		SMTPPort:  587,
+		Timeout:   10 * time.Second,
+	}
+
+	LDAP = LDAPConfig{
+		UserFilter:        "(uid=%s)",
+		UsernameAttribute: "uid",
+		GroupAttribute:    "memberOf",
+		Timeout:           10 * time.Second,
+	}
+
+	//Вход через OpenID Connect, пустой OIDC_ISSUER - выключен
+	OIDC = OIDCConfig{
+		Scopes:        []string{"openid", "profile", "email"},
+		UsernameClaim: "preferred_username",
+		GroupsClaim:   "groups",
+		AutoProvision: true,
+		PostLoginURL:  "/",
+	}
+
+	SecretScanMode  = SecretScanWarn
+	SecretScanRules = ""
+
+	RenderStyle     = "github"
+	RenderCacheSize = 256
+
+	S3 = S3Config{
+		Region:    "us-east-1",
+		PathStyle: true,
+	}
+)
+
+type OIDCConfig struct {
+	Issuer       string
+	ClientId     string
+	ClientSecret string
+	RedirectURL  string //Адрес /rest/oidc/callback, как он зарегистрирован у провайдера
+	Scopes       []string
+
+	UsernameClaim string
+	GroupsClaim   string
+	AdminGroup    string //Члены группы получают права администратора, пусто - права не меняются
+
+	AutoProvision bool   //Создавать пользователя при первом входе
+	PostLoginURL  string //Куда вернуть браузер после входа, если не передан redirect
+	SkipTwoFactor bool   //Не спрашивать 2FA pasteGo: второй фактор проверяет провайдер
+}
+
+type LDAPConfig struct {
+	URL          string //ldap:// или ldaps://
+	StartTLS     bool
+	BindDN       string //Сервисная учётная запись для поиска, пусто - анонимный поиск
+	BindPassword string
+	BaseDN       string
+
+	UserFilter        string //%s заменяется экранированным именем пользователя
+	UsernameAttribute string
+	GroupAttribute    string
+	RequiredGroup     string //DN группы, без членства в ней вход запрещён, пусто - пускать всех
+	AdminGroup        string //Члены группы получают права администратора, пусто - права не меняются
+	Timeout           time.Duration
+}
+
+type MailConfig struct {
+	Transport string
+	From      string
+	File      string //Файл для transport=file, письма дописываются построчно в JSON
+
+	SMTPHost     string
+	SMTPPort     int64
+	SMTPUsername string
+	SMTPPassword string
+	SMTPTLS      bool //Соединение сразу по TLS (порт 465), иначе STARTTLS, если сервер его предлагает
+	Timeout      time.Duration
+}
+
+type S3Config struct {
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	PathStyle bool
+}
+
+func Load() error {
+	var err error
+
+	BlobStore = getEnv("BLOB_STORE", BlobStore)
+	BlobDir = getEnv("BLOB_DIR", BlobDir)
+	if MaxAttachmentSize, err = getEnvInt64("MAX_ATTACHMENT_SIZE", MaxAttachmentSize); err != nil {
+		return err
+	}
+
+	if MaxPasteSize, err = getEnvInt64("MAX_PASTE_SIZE", MaxPasteSize); err != nil {
+		return err
+	}
+	if DefaultMaxPastes, err = getEnvInt64("DEFAULT_MAX_PASTES", DefaultMaxPastes); err != nil {
+		return err
+	}
+	if DefaultMaxStorage, err = getEnvInt64("DEFAULT_MAX_STORAGE", DefaultMaxStorage); err != nil {
+		return err
+	}
+
+	MaxPasteLifetime = getEnv("MAX_PASTE_LIFETIME", MaxPasteLifetime)
+	if RetentionInterval, err = getEnvDuration("RETENTION_INTERVAL", RetentionInterval); err != nil {
+		return err
+	}
+
+	RateLimitStore = getEnv("RATE_LIMIT_STORE", RateLimitStore)
+	if RateLimitBurst, err = getEnvInt64("RATE_LIMIT_BURST", RateLimitBurst); err != nil {
+		return err
+	}
+	if RateLimitPerMinute, err = getEnvInt64("RATE_LIMIT_PER_MINUTE", RateLimitPerMinute); err != nil {
+		return err
+	}
+	if LockoutThreshold, err = getEnvInt64("LOCKOUT_THRESHOLD", LockoutThreshold); err != nil {
+		return err
+	}
+	if LockoutBase, err = getEnvDuration("LOCKOUT_BASE", LockoutBase); err != nil {
+		return err
+	}
+	if LockoutMax, err = getEnvDuration("LOCKOUT_MAX", LockoutMax); err != nil {
+		return err
+	}
+
+	TrustedProxies = nil
+	for _, proxy := range strings.Split(getEnv("TRUSTED_PROXIES", ""), ",") {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			TrustedProxies = append(TrustedProxies, proxy)
+		}
+	}
+
+	TotpIssuer = getEnv("TOTP_ISSUER", TotpIssuer)
+
+	if LocalPasswords, err = getEnvBool("LOCAL_PASSWORDS", LocalPasswords); err != nil {
+		return err
+	}
+	OIDC.Issuer = strings.TrimSuffix(getEnv("OIDC_ISSUER", OIDC.Issuer), "/")
+	OIDC.ClientId = getEnv("OIDC_CLIENT_ID", OIDC.ClientId)
+	OIDC.ClientSecret = getEnv("OIDC_CLIENT_SECRET", OIDC.ClientSecret)
+	OIDC.RedirectURL = getEnv("OIDC_REDIRECT_URL", OIDC.RedirectURL)
+	if scopes := getEnv("OIDC_SCOPES", ""); scopes != "" {
+		OIDC.Scopes = strings.Fields(strings.ReplaceAll(scopes, ",", " "))
+	}
+	OIDC.UsernameClaim = getEnv("OIDC_USERNAME_CLAIM", OIDC.UsernameClaim)
+	OIDC.GroupsClaim = getEnv("OIDC_GROUPS_CLAIM", OIDC.GroupsClaim)
+	OIDC.AdminGroup = getEnv("OIDC_ADMIN_GROUP", OIDC.AdminGroup)
+	if OIDC.AutoProvision, err = getEnvBool("OIDC_AUTO_PROVISION", OIDC.AutoProvision); err != nil {
+		return err
+	}
+	OIDC.PostLoginURL = getEnv("OIDC_POST_LOGIN_URL", OIDC.PostLoginURL)
+	if OIDC.SkipTwoFactor, err = getEnvBool("OIDC_SKIP_2FA", OIDC.SkipTwoFactor); err != nil {
+		return err
+	}
+	if OIDC.Issuer != "" && (OIDC.ClientId == "" || OIDC.RedirectURL == "") {
+		return fmt.Errorf("OIDC_ISSUER: OIDC_CLIENT_ID and OIDC_REDIRECT_URL are required")
+	}
+
+	AuthBackends = nil
+	for _, backend := range strings.Split(getEnv("AUTH_BACKENDS", "local"), ",") {
+		switch backend = strings.TrimSpace(backend); backend {
+		case "":
+		case "local", "ldap":
+			AuthBackends = append(AuthBackends, backend)
+		default:
+			return fmt.Errorf("AUTH_BACKENDS: unknown backend %q", backend)
+		}
+	}
+	LDAP.URL = getEnv("LDAP_URL", LDAP.URL)
+	if LDAP.StartTLS, err = getEnvBool("LDAP_START_TLS", LDAP.StartTLS); err != nil {
+		return err
+	}
+	LDAP.BindDN = getEnv("LDAP_BIND_DN", LDAP.BindDN)
+	LDAP.BindPassword = getEnv("LDAP_BIND_PASSWORD", LDAP.BindPassword)
+	LDAP.BaseDN = getEnv("LDAP_BASE_DN", LDAP.BaseDN)
+	LDAP.UserFilter = getEnv("LDAP_USER_FILTER", LDAP.UserFilter)
+	LDAP.UsernameAttribute = getEnv("LDAP_USERNAME_ATTRIBUTE", LDAP.UsernameAttribute)
+	LDAP.GroupAttribute = getEnv("LDAP_GROUP_ATTRIBUTE", LDAP.GroupAttribute)
+	LDAP.RequiredGroup = getEnv("LDAP_REQUIRED_GROUP", LDAP.RequiredGroup)
+	LDAP.AdminGroup = getEnv("LDAP_ADMIN_GROUP", LDAP.AdminGroup)
+	if LDAP.Timeout, err = getEnvDuration("LDAP_TIMEOUT", LDAP.Timeout); err != nil {
+		return err
+	}
+	if slices.Contains(AuthBackends, "ldap") && (LDAP.URL == "" || LDAP.BaseDN == "") {
+		return fmt.Errorf("AUTH_BACKENDS: ldap requires LDAP_URL and LDAP_BASE_DN")
+	}
+
+	if UsernameMinLength, err = getEnvInt64("USERNAME_MIN_LENGTH", UsernameMinLength); err != nil {
+		return err
+	}
+	if UsernameMaxLength, err = getEnvInt64("USERNAME_MAX_LENGTH", UsernameMaxLength); err != nil {
+		return err
+	}
+	if UsernameMinLength < 1 || UsernameMaxLength < UsernameMinLength {
+		return fmt.Errorf("USERNAME_MIN_LENGTH, USERNAME_MAX_LENGTH: need 1 <= min <= max")
+	}
+	UsernamePattern = getEnv("USERNAME_PATTERN", UsernamePattern)
+	if reserved, ok := os.LookupEnv("RESERVED_USERNAMES"); ok {
+		ReservedUsernames = nil
+		for _, name := range strings.Split(reserved, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				ReservedUsernames = append(ReservedUsernames, name)
+			}
+		}
+	}
+	if PasswordMinLength, err = getEnvInt64("PASSWORD_MIN_LENGTH", PasswordMinLength); err != nil {
+		return err
+	}
+	if PasswordMaxLength, err = getEnvInt64("PASSWORD_MAX_LENGTH", PasswordMaxLength); err != nil {
+		return err
+	}
+	if PasswordMinLength < 1 || PasswordMaxLength < PasswordMinLength {
+		return fmt.Errorf("PASSWORD_MIN_LENGTH, PASSWORD_MAX_LENGTH: need 1 <= min <= max")
+	}
+	if PasswordMinClasses, err = getEnvInt64("PASSWORD_MIN_CLASSES", PasswordMinClasses); err != nil {
+		return err
+	}
+	if PasswordMinClasses < 1 || PasswordMinClasses > 4 {
+		return fmt.Errorf("PASSWORD_MIN_CLASSES: must be from 1 to 4")
+	}
+	PasswordBreachList = getEnv("PASSWORD_BREACH_LIST", PasswordBreachList)
+
+	if PasswordResetTTL, err = getEnvDuration("PASSWORD_RESET_TTL", PasswordResetTTL); err != nil {
+		return err
+	}
+	PasswordResetURL = getEnv("PASSWORD_RESET_URL", PasswordResetURL)
+
+	Mail.Transport = getEnv("MAIL_TRANSPORT", Mail.Transport)
+	Mail.From = getEnv("MAIL_FROM", Mail.From)
+	Mail.File = getEnv("MAIL_FILE", Mail.File)
+	Mail.SMTPHost = getEnv("SMTP_HOST", Mail.SMTPHost)
+	if Mail.SMTPPort, err = getEnvInt64("SMTP_PORT", Mail.SMTPPort); err != nil {
+		return err
+	}
+	Mail.SMTPUsername = getEnv("SMTP_USERNAME", Mail.SMTPUsername)
+	Mail.SMTPPassword = getEnv("SMTP_PASSWORD", Mail.SMTPPassword)
+	if Mail.SMTPTLS, err = getEnvBool("SMTP_TLS", Mail.SMTPTLS); err != nil {
+		return err
+	}
+	if Mail.Timeout, err = getEnvDuration("SMTP_TIMEOUT", Mail.Timeout); err != nil {
+		return err
+	}
+	switch Mail.Transport {
+	case "none", "log", "file":
+	case "smtp":
+		if Mail.SMTPHost == "" || Mail.From == "" {
+			return fmt.Errorf("MAIL_TRANSPORT: smtp requires SMTP_HOST and MAIL_FROM")
+		}
+	default:
+		return fmt.Errorf("MAIL_TRANSPORT: unknown transport %q", Mail.Transport)
+	}
+
+	if !LocalPasswords && OIDC.Issuer == "" && !slices.Contains(AuthBackends, "ldap") {
+		return fmt.Errorf("LOCAL_PASSWORDS: password login can only be disabled with OIDC_ISSUER or the ldap backend")
+	}
+
+	SecretScanMode = getEnv("SECRET_SCAN_MODE", SecretScanMode)
+	switch SecretScanMode {
+	case SecretScanOff, SecretScanWarn, SecretScanPrivate, SecretScanReject:
+	default:
+		return fmt.Errorf("SECRET_SCAN_MODE: unknown mode %q", SecretScanMode)
+	}
+	SecretScanRules = getEnv("SECRET_SCAN_RULES", SecretScanRules)
+
+	RenderStyle = getEnv("RENDER_STYLE", RenderStyle)
+	cacheSize, err := getEnvInt64("RENDER_CACHE_SIZE", int64(RenderCacheSize))
+	if err != nil {
+		return err
+	}
+	RenderCacheSize = int(cacheSize)
+
+	S3.Endpoint = strings.TrimSuffix(getEnv("S3_ENDPOINT", S3.Endpoint), "/")
+	S3.Region = getEnv("S3_REGION", S3.Region)
+	S3.Bucket = getEnv("S3_BUCKET", S3.Bucket)
+	S3.AccessKey = getEnv("S3_ACCESS_KEY", S3.AccessKey)
+	S3.SecretKey = getEnv("S3_SECRET_KEY", S3.SecretKey)
+	if S3.PathStyle, err = getEnvBool("S3_PATH_STYLE", S3.PathStyle); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func getEnv(key string, def string) string {
+	if value, ok := os.LookupEnv(key); ok && value != "" {
+		return value
+	}
+	return def
+}
+
+func getEnvInt64(key string, def int64) (int64, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return def, nil
+	}
+	parsed, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", key, err)
+	}
+	return parsed, nil
+}
+
+func getEnvDuration(key string, def time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return def, nil
+	}
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", key, err)
+	}
+	return parsed, nil
+}
+
+func getEnvBool(key string, def bool) (bool, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return def, nil
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", key, err)
+	}
+	return parsed, nil
+}
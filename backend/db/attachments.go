@@ -0,0 +1,70 @@
+package db
+
+import (
+	"database/sql"
+	"pasteGo/backend/db/typesDB"
+)
+
+///ATTACHMENTS
+
+func (instance *DBInstance) GetAttachmentRecordById(id string) (typesDB.AttachmentRecord, bool, error) {
+	query := "SELECT paste_id, name, content_type, size, created FROM attachments WHERE id = ?"
+	record := typesDB.AttachmentRecord{Id: id}
+	err := instance.db.QueryRow(query, id).Scan(&record.PasteId, &record.Name, &record.ContentType, &record.Size, &record.Created)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return typesDB.AttachmentRecord{}, false, nil
+		}
+		return typesDB.AttachmentRecord{}, false, err
+	}
+	return record, true, nil
+}
+
+func (instance *DBInstance) GetAttachmentRecordsByPasteId(pasteId string) ([]typesDB.AttachmentRecord, error) {
+	query := "SELECT id, paste_id, name, content_type, size, created FROM attachments WHERE paste_id = ? ORDER BY created, name"
+	return instance.queryAttachmentRecords(query, pasteId)
+}
+
+func (instance *DBInstance) GetAttachmentRecordsByUserId(userId string) ([]typesDB.AttachmentRecord, error) {
+	query := `SELECT a.id, a.paste_id, a.name, a.content_type, a.size, a.created
+		FROM attachments a JOIN pastes p ON p.id = a.paste_id
+		WHERE p.user_id = ?`
+	return instance.queryAttachmentRecords(query, userId)
+}
+
+func (instance *DBInstance) queryAttachmentRecords(query string, args ...any) ([]typesDB.AttachmentRecord, error) {
+	records := make([]typesDB.AttachmentRecord, 0)
+	rows, err := instance.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record typesDB.AttachmentRecord
+		if err := rows.Scan(&record.Id, &record.PasteId, &record.Name, &record.ContentType, &record.Size, &record.Created); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, rows.Err()
+}
+
+func (instance *DBInstance) AddAttachmentRecord(record *typesDB.AttachmentRecord) (bool, error) {
+	query := "INSERT INTO attachments (id, paste_id, name, content_type, size, created) VALUES (?, ?, ?, ?, ?, ?)"
+	statement, err := instance.db.Prepare(query)
+	if err != nil {
+		return false, err
+	}
+	defer statement.Close()
+
+	res, err := statement.Exec(record.Id, record.PasteId, record.Name, record.ContentType, record.Size, record.Created)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected > 0 {
+		return true, nil
+	}
+	return false, nil
+}
@@ -0,0 +1,86 @@
+package db
+
+import (
+	"pasteGo/backend/db/typesDB"
+	"strings"
+)
+
+///AUDIT
+
+func (instance *DBInstance) AddAuditEventRecord(record *typesDB.AuditEventRecord) error {
+	res, err := instance.db.Exec("INSERT INTO audit_events (time, actor, action, target, ip, user_agent, detail) VALUES (?, ?, ?, ?, ?, ?, ?)",
+		record.Time, record.Actor, record.Action, record.Target, record.Ip, record.UserAgent, record.Detail)
+	if err != nil {
+		return err
+	}
+	record.Id, _ = res.LastInsertId()
+	return nil
+}
+
+// GetAuditEventRecords возвращает события по фильтру, новые первыми
+func (instance *DBInstance) GetAuditEventRecords(filter typesDB.AuditFilter) ([]typesDB.AuditEventRecord, error) {
+	records := make([]typesDB.AuditEventRecord, 0)
+	err := instance.scanAuditEventRecords(filter, "DESC", func(record *typesDB.AuditEventRecord) error {
+		records = append(records, *record)
+		return nil
+	})
+	return records, err
+}
+
+// EachAuditEventRecord передаёт события по фильтру в fn по одному, старые первыми.
+// Для выгрузки всего журнала без загрузки в память
+func (instance *DBInstance) EachAuditEventRecord(filter typesDB.AuditFilter, fn func(record *typesDB.AuditEventRecord) error) error {
+	return instance.scanAuditEventRecords(filter, "ASC", fn)
+}
+
+func (instance *DBInstance) scanAuditEventRecords(filter typesDB.AuditFilter, order string, fn func(record *typesDB.AuditEventRecord) error) error {
+	conditions := make([]string, 0, 5)
+	args := make([]any, 0, 6)
+	if filter.Actor != "" {
+		conditions = append(conditions, "actor = ?")
+		args = append(args, filter.Actor)
+	}
+	if filter.Action != "" {
+		conditions = append(conditions, "action = ?")
+		args = append(args, filter.Action)
+	}
+	if filter.Target != "" {
+		conditions = append(conditions, "target = ?")
+		args = append(args, filter.Target)
+	}
+	if filter.Since > 0 {
+		conditions = append(conditions, "time >= ?")
+		args = append(args, filter.Since)
+	}
+	if filter.Until > 0 {
+		conditions = append(conditions, "time < ?")
+		args = append(args, filter.Until)
+	}
+
+	query := "SELECT id, time, actor, action, target, ip, user_agent, detail FROM audit_events"
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY id " + order
+	if filter.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, filter.Limit)
+	}
+
+	rows, err := instance.db.Query(query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record typesDB.AuditEventRecord
+		if err := rows.Scan(&record.Id, &record.Time, &record.Actor, &record.Action, &record.Target, &record.Ip, &record.UserAgent, &record.Detail); err != nil {
+			return err
+		}
+		if err := fn(&record); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"pasteGo/backend/db/typesDB"
+	"pasteGo/backend/validation"
 
 	_ "github.com/mattn/go-sqlite3"
 )
@@ -33,7 +34,8 @@ func createDBConnection() (*sql.DB, error) {
 		return nil, err
 	}
 
-	db, err := sql.Open("sqlite3", "./data/database.db")
+	//_foreign_keys включает каскады на каждом соединении пула, а не только на первом
+	db, err := sql.Open("sqlite3", "./data/database.db?_foreign_keys=on")
 	if err != nil {
 		return nil, err
 	}
@@ -85,6 +87,29 @@ func (instance *DBInstance) Init() error {
 		FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
     );
 
+	CREATE TABLE IF NOT EXISTS recovery_codes (
+        user_id TEXT NOT NULL,
+		code_hash TEXT NOT NULL,
+		PRIMARY KEY (user_id, code_hash),
+		FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+    );
+
+	CREATE TABLE IF NOT EXISTS password_resets (
+        token_hash TEXT PRIMARY KEY,
+		user_id TEXT NOT NULL,
+		expires INTEGER NOT NULL,
+		FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+    );
+
+	CREATE TABLE IF NOT EXISTS identities (
+        provider TEXT NOT NULL,
+		subject TEXT NOT NULL,
+		user_id TEXT NOT NULL,
+		created INTEGER NOT NULL,
+		PRIMARY KEY (provider, subject),
+		FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+    );
+
 	CREATE TABLE IF NOT EXISTS pastes (
         id TEXT PRIMARY KEY,
 		user_id TEXT NOT NULL,
@@ -95,22 +120,253 @@ func (instance *DBInstance) Init() error {
 		password TEXT NOT NULL,
 		public INTEGER NOT NULL DEFAULT 0,
 		FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
-    );`
+    );
+
+	CREATE TABLE IF NOT EXISTS teams (
+        id TEXT PRIMARY KEY,
+		name TEXT NOT NULL,
+		name_key TEXT NOT NULL UNIQUE,
+		created INTEGER NOT NULL
+    );
+
+	CREATE TABLE IF NOT EXISTS team_members (
+        team_id TEXT NOT NULL,
+		user_id TEXT NOT NULL,
+		role TEXT NOT NULL,
+		joined INTEGER NOT NULL,
+		PRIMARY KEY (team_id, user_id),
+		FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE CASCADE,
+		FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+    );
+	CREATE INDEX IF NOT EXISTS team_members_user ON team_members (user_id);
+
+	CREATE TABLE IF NOT EXISTS paste_shares (
+        id TEXT PRIMARY KEY,
+		paste_id TEXT NOT NULL,
+		user_id TEXT,
+		group_name TEXT NOT NULL DEFAULT '' COLLATE NOCASE,
+		permission TEXT NOT NULL,
+		created INTEGER NOT NULL,
+		FOREIGN KEY (paste_id) REFERENCES pastes(id) ON DELETE CASCADE,
+		FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+    );
+	CREATE UNIQUE INDEX IF NOT EXISTS paste_shares_grantee ON paste_shares (paste_id, IFNULL(user_id, ''), group_name);
+	CREATE INDEX IF NOT EXISTS paste_shares_user ON paste_shares (user_id);
+	CREATE INDEX IF NOT EXISTS paste_shares_group ON paste_shares (group_name);
+
+	-- Ссылки на вставку для тех, у кого нет аккаунта. max_views = 0 - без ограничения просмотров
+	CREATE TABLE IF NOT EXISTS paste_links (
+        id TEXT PRIMARY KEY,
+		paste_id TEXT NOT NULL,
+		expires INTEGER NOT NULL,
+		max_views INTEGER NOT NULL DEFAULT 0,
+		views INTEGER NOT NULL DEFAULT 0,
+		created INTEGER NOT NULL,
+		FOREIGN KEY (paste_id) REFERENCES pastes(id) ON DELETE CASCADE
+    );
+	CREATE INDEX IF NOT EXISTS paste_links_paste ON paste_links (paste_id);
+
+	-- Группы LDAP/OIDC, в которых пользователь состоял при последнем входе через source
+	CREATE TABLE IF NOT EXISTS user_groups (
+        user_id TEXT NOT NULL,
+		source TEXT NOT NULL,
+		name TEXT NOT NULL COLLATE NOCASE,
+		PRIMARY KEY (user_id, source, name),
+		FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+    );
+
+	CREATE TABLE IF NOT EXISTS paste_files (
+        paste_id TEXT NOT NULL,
+		position INTEGER NOT NULL,
+		name TEXT NOT NULL,
+		language TEXT NOT NULL DEFAULT '',
+		text TEXT NOT NULL,
+		PRIMARY KEY (paste_id, name),
+		FOREIGN KEY (paste_id) REFERENCES pastes(id) ON DELETE CASCADE
+    );
+
+	CREATE TABLE IF NOT EXISTS attachments (
+        id TEXT PRIMARY KEY,
+		paste_id TEXT NOT NULL,
+		name TEXT NOT NULL,
+		content_type TEXT NOT NULL,
+		size INTEGER NOT NULL,
+		created INTEGER NOT NULL,
+		FOREIGN KEY (paste_id) REFERENCES pastes(id) ON DELETE CASCADE
+    );
+
+	CREATE TABLE IF NOT EXISTS user_quotas (
+        user_id TEXT PRIMARY KEY,
+		max_pastes INTEGER,
+		max_storage INTEGER,
+		FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+    );
+
+	CREATE TABLE IF NOT EXISTS retention_policies (
+        id TEXT PRIMARY KEY,
+		scope TEXT NOT NULL,
+		max_age TEXT NOT NULL,
+		created INTEGER NOT NULL
+    );
+
+	CREATE TABLE IF NOT EXISTS retention_events (
+        id INTEGER PRIMARY KEY AUTOINCREMENT,
+		time INTEGER NOT NULL,
+		action TEXT NOT NULL,
+		policy_id TEXT NOT NULL DEFAULT '',
+		paste_id TEXT NOT NULL DEFAULT '',
+		user_id TEXT NOT NULL DEFAULT '',
+		actor TEXT NOT NULL DEFAULT '',
+		detail TEXT NOT NULL DEFAULT ''
+    );
+
+	CREATE TABLE IF NOT EXISTS audit_events (
+        id INTEGER PRIMARY KEY AUTOINCREMENT,
+		time INTEGER NOT NULL,
+		actor TEXT NOT NULL DEFAULT '',
+		action TEXT NOT NULL,
+		target TEXT NOT NULL DEFAULT '',
+		ip TEXT NOT NULL DEFAULT '',
+		user_agent TEXT NOT NULL DEFAULT '',
+		detail TEXT NOT NULL DEFAULT ''
+    );
+	CREATE INDEX IF NOT EXISTS audit_events_time ON audit_events (time);
+
+	-- Журнал аудита только дополняется
+	CREATE TRIGGER IF NOT EXISTS audit_events_no_update BEFORE UPDATE ON audit_events
+	BEGIN
+		SELECT RAISE(ABORT, 'audit_events is append-only');
+	END;
+	CREATE TRIGGER IF NOT EXISTS audit_events_no_delete BEFORE DELETE ON audit_events
+	BEGIN
+		SELECT RAISE(ABORT, 'audit_events is append-only');
+	END;`
 
 	_, err = instance.db.Exec(initSQL)
 	if err != nil {
 		return err
 	}
 
+	return instance.migrate()
+}
+
+// Колонки, добавленные после первой версии схемы. Для существующих баз
+// они докатываются через ALTER TABLE при старте.
+var columnMigrations = []struct {
+	table      string
+	column     string
+	definition string
+}{
+	{typesDB.PastesTable, "title", "TEXT NOT NULL DEFAULT ''"},
+	{typesDB.PastesTable, "language", "TEXT NOT NULL DEFAULT ''"},
+	{typesDB.UsersTable, "admin", "INTEGER NOT NULL DEFAULT 0"},
+	{typesDB.PastesTable, "language_confidence", "REAL NOT NULL DEFAULT 0"},
+	{typesDB.PastesTable, "content_type", "TEXT NOT NULL DEFAULT 'text'"},
+	{typesDB.PastesTable, "forked_from", "TEXT REFERENCES pastes(id) ON DELETE SET NULL"},
+	{typesDB.PastesTable, "version", "INTEGER NOT NULL DEFAULT 1"},
+	{typesDB.PastesTable, "legal_hold", "INTEGER NOT NULL DEFAULT 0"},
+	{typesDB.UsersTable, "deleted", "INTEGER NOT NULL DEFAULT 0"},
+	{typesDB.UsersTable, "totp_secret", "TEXT NOT NULL DEFAULT ''"},
+	{typesDB.UsersTable, "totp_enabled", "INTEGER NOT NULL DEFAULT 0"},
+	{typesDB.UsersTable, "totp_last_step", "INTEGER NOT NULL DEFAULT 0"},
+	{typesDB.UsersTable, "email", "TEXT NOT NULL DEFAULT ''"},
+	{typesDB.UsersTable, "username_key", "TEXT NOT NULL DEFAULT ''"},
+	//Команду с вставками не удалить: ограничение без каскада
+	{typesDB.PastesTable, "team_id", "TEXT REFERENCES teams(id)"},
+}
+
+// Индексы по колонкам из columnMigrations создаются после них
+var indexMigrations = []string{
+	"CREATE UNIQUE INDEX IF NOT EXISTS users_email ON users (email COLLATE NOCASE) WHERE email != ''",
+	"CREATE INDEX IF NOT EXISTS users_username_key ON users (username_key)",
+	"CREATE INDEX IF NOT EXISTS pastes_team ON pastes (team_id) WHERE team_id IS NOT NULL",
+}
+
+func (instance *DBInstance) migrate() error {
+	for _, m := range columnMigrations {
+		if err := instance.addColumnIfNotExists(m.table, m.column, m.definition); err != nil {
+			return err
+		}
+	}
+	if err := instance.fillUsernameKeys(); err != nil {
+		return err
+	}
+	for _, index := range indexMigrations {
+		if _, err := instance.db.Exec(index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fillUsernameKeys считает ключи уникальности для пользователей, созданных до их появления.
+// Уникальный индекс не строится: в старых базах могут быть имена, различающиеся только регистром
+func (instance *DBInstance) fillUsernameKeys() error {
+	rows, err := instance.db.Query("SELECT id, username FROM users WHERE username_key = ''")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	keys := make(map[string]string)
+	for rows.Next() {
+		var id, username string
+		if err := rows.Scan(&id, &username); err != nil {
+			return err
+		}
+		keys[id] = validation.UsernameKey(username)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+
+	for id, key := range keys {
+		if _, err := instance.db.Exec("UPDATE users SET username_key = ? WHERE id = ?", key, id); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+func (instance *DBInstance) addColumnIfNotExists(table string, column string, definition string) error {
+	rows, err := instance.db.Query(fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			cid        int
+			name       string
+			columnType string
+			notNull    int
+			defValue   sql.NullString
+			primaryKey int
+		)
+		if err := rows.Scan(&cid, &name, &columnType, &notNull, &defValue, &primaryKey); err != nil {
+			return err
+		}
+		if name == column {
+			return nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+
+	_, err = instance.db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, definition))
+	return err
+}
+
 ///USERS
 
 func (instance *DBInstance) GetUserRecordById(id string) (typesDB.UserRecord, bool, error) {
-	query := "SELECT username, password FROM users WHERE id = ?"
+	query := "SELECT username, password, admin, deleted, email, totp_secret, totp_enabled, totp_last_step FROM users WHERE id = ?"
 	record := typesDB.UserRecord{Id: id}
-	err := instance.db.QueryRow(query, id).Scan(&record.Username, &record.Password)
+	err := instance.db.QueryRow(query, id).Scan(&record.Username, &record.Password, &record.Admin, &record.Deleted, &record.Email, &record.TotpSecret, &record.TotpEnabled, &record.TotpLastStep)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return typesDB.UserRecord{}, false, nil
@@ -121,9 +377,9 @@ func (instance *DBInstance) GetUserRecordById(id string) (typesDB.UserRecord, bo
 }
 
 func (instance *DBInstance) GetUserRecordByUsername(username string) (typesDB.UserRecord, bool, error) {
-	query := "SELECT id, password FROM users WHERE username = ?"
+	query := "SELECT id, password, admin, deleted, email, totp_secret, totp_enabled, totp_last_step FROM users WHERE username = ?"
 	record := typesDB.UserRecord{Username: username}
-	err := instance.db.QueryRow(query, username).Scan(&record.Id, &record.Password)
+	err := instance.db.QueryRow(query, username).Scan(&record.Id, &record.Password, &record.Admin, &record.Deleted, &record.Email, &record.TotpSecret, &record.TotpEnabled, &record.TotpLastStep)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return typesDB.UserRecord{}, false, nil
@@ -133,6 +389,19 @@ func (instance *DBInstance) GetUserRecordByUsername(username string) (typesDB.Us
 	return record, true, nil
 }
 
+// GetUserRecordByEmail ищет пользователя по адресу без учёта регистра
+func (instance *DBInstance) GetUserRecordByEmail(email string) (typesDB.UserRecord, bool, error) {
+	var id string
+	err := instance.db.QueryRow("SELECT id FROM users WHERE email = ? COLLATE NOCASE AND email != ''", email).Scan(&id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return typesDB.UserRecord{}, false, nil
+		}
+		return typesDB.UserRecord{}, false, err
+	}
+	return instance.GetUserRecordById(id)
+}
+
 func (instance *DBInstance) AddUserRecord(record *typesDB.UserRecord) (bool, error) {
 	exists, err := instance.checkRecordExistsUser(record.Username)
 	if err != nil {
@@ -142,14 +411,14 @@ func (instance *DBInstance) AddUserRecord(record *typesDB.UserRecord) (bool, err
 		return false, nil
 	}
 
-	query := "INSERT INTO users (id, username, password) VALUES (?, ?, ?) ON CONFLICT(username) DO NOTHING"
+	query := "INSERT INTO users (id, username, username_key, password, email) VALUES (?, ?, ?, ?, ?) ON CONFLICT DO NOTHING"
 	statement, err := instance.db.Prepare(query)
 	if err != nil {
 		return false, err
 	}
 	defer statement.Close()
 
-	res, err := statement.Exec(record.Id, record.Username, record.Password)
+	res, err := statement.Exec(record.Id, record.Username, validation.UsernameKey(record.Username), record.Password, record.Email)
 	if err != nil {
 		return false, err
 	}
@@ -161,9 +430,15 @@ func (instance *DBInstance) AddUserRecord(record *typesDB.UserRecord) (bool, err
 }
 
 func (instance *DBInstance) checkRecordExistsUser(username string) (bool, error) {
+	return instance.IsUsernameTaken(username, "")
+}
+
+// IsUsernameTaken сравнивает имена без учёта регистра и формы Unicode.
+// Пользователь exceptId не считается: он может поменять регистр своего имени
+func (instance *DBInstance) IsUsernameTaken(username string, exceptId string) (bool, error) {
 	var exists bool
-	query := "SELECT EXISTS(SELECT 1 FROM users WHERE username = ?)"
-	err := instance.db.QueryRow(query, username).Scan(&exists)
+	query := "SELECT EXISTS(SELECT 1 FROM users WHERE username_key = ? AND id != ?)"
+	err := instance.db.QueryRow(query, validation.UsernameKey(username), exceptId).Scan(&exists)
 	if err != nil {
 		return false, err
 	}
@@ -171,80 +446,174 @@ func (instance *DBInstance) checkRecordExistsUser(username string) (bool, error)
 }
 
 func (instance *DBInstance) EditUserRecord(record *typesDB.UserRecord) error {
-	query := "UPDATE users SET username = ?, password = ? WHERE id = ?"
+	query := "UPDATE users SET username = ?, username_key = ?, password = ?, email = ? WHERE id = ?"
 	statement, err := instance.db.Prepare(query)
 	if err != nil {
 		return err
 	}
 	defer statement.Close()
 
-	_, err = statement.Exec(record.Username, record.Password, record.Id)
+	_, err = statement.Exec(record.Username, validation.UsernameKey(record.Username), record.Password, record.Email, record.Id)
+	return err
+}
+
+// RehashUserPassword заменяет хеш пароля, если его не сменили после проверки
+func (instance *DBInstance) RehashUserPassword(id string, oldHash string, newHash string) error {
+	_, err := instance.db.Exec("UPDATE users SET password = ? WHERE id = ? AND password = ?", newHash, id, oldHash)
+	return err
+}
+
+func (instance *DBInstance) SetUserAdmin(id string, admin bool) error {
+	_, err := instance.db.Exec("UPDATE users SET admin = ? WHERE id = ?", typesDB.BoolToInt(admin), id)
 	return err
 }
 
 ///PASTES
 
-func (instance *DBInstance) GetPasteRecordById(pasteId string) (typesDB.PasteRecord, bool, error) {
-	query := "SELECT user_id, text, created, updated, lifetime, password, public FROM pastes WHERE id = ?"
-	record := typesDB.PasteRecord{Id: pasteId}
-	err := instance.db.QueryRow(query, pasteId).Scan(&record.UserId, &record.Text, &record.Created, &record.Updated, &record.Lifetime, &record.Password, &record.Public)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return typesDB.PasteRecord{}, false, nil
-		}
-		return typesDB.PasteRecord{}, false, err
-	}
-	return record, true, nil
+const pasteColumns = "id, user_id, title, language, language_confidence, content_type, text, created, updated, lifetime, password, public, forked_from, version, legal_hold, team_id"
+
+const insertPasteQuery = "INSERT INTO pastes (id, user_id, title, language, language_confidence, content_type, text, lifetime, created, updated, password, public, forked_from, team_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
+
+type rowScanner interface {
+	Scan(dest ...any) error
 }
 
-func (instance *DBInstance) GetPasteRecordsByUserId(userId string) (*[]typesDB.PasteRecord, error) {
-	query := "SELECT id, text, created, updated, lifetime, password, public FROM pastes WHERE user_id = ?"
+func scanPasteRecord(row rowScanner, record *typesDB.PasteRecord) error {
+	var forkedFrom, teamId sql.NullString
+	err := row.Scan(&record.Id, &record.UserId, &record.Title, &record.Language, &record.LanguageConfidence, &record.ContentType, &record.Text, &record.Created, &record.Updated, &record.Lifetime, &record.Password, &record.Public, &forkedFrom, &record.Version, &record.LegalHold, &teamId)
+	record.ForkedFrom, record.TeamId = forkedFrom.String, teamId.String
+	return err
+}
+
+func (instance *DBInstance) queryPasteRecords(query string, args ...any) (*[]typesDB.PasteRecord, error) {
 	records := make([]typesDB.PasteRecord, 0, 10)
-	rows, err := instance.db.Query(query, userId)
+	rows, err := instance.db.Query(query, args...)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, nil
-		}
 		return nil, err
 	}
+	defer rows.Close()
 
 	for rows.Next() {
 		var record typesDB.PasteRecord
-		rows.Scan(&record.Id, &record.Text, &record.Created, &record.Updated, &record.Lifetime, &record.Password, &record.Public)
-		record.UserId = userId
+		if err := scanPasteRecord(rows, &record); err != nil {
+			return nil, err
+		}
 		records = append(records, record)
 	}
-	return &records, nil
+	return &records, rows.Err()
+}
+
+func (instance *DBInstance) GetPasteRecordById(pasteId string) (typesDB.PasteRecord, bool, error) {
+	query := "SELECT " + pasteColumns + " FROM pastes WHERE id = ?"
+	record := typesDB.PasteRecord{}
+	err := scanPasteRecord(instance.db.QueryRow(query, pasteId), &record)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return typesDB.PasteRecord{}, false, nil
+		}
+		return typesDB.PasteRecord{}, false, err
+	}
+	return record, true, nil
+}
+
+// GetPasteRecordsByUserId возвращает личные вставки пользователя, вставки команд в список не входят
+func (instance *DBInstance) GetPasteRecordsByUserId(userId string) (*[]typesDB.PasteRecord, error) {
+	query := "SELECT " + pasteColumns + " FROM pastes WHERE user_id = ? AND team_id IS NULL"
+	return instance.queryPasteRecords(query, userId)
 }
 
 func (instance *DBInstance) AddPasteRecord(record *typesDB.PasteRecord) (bool, error) {
-	query := "INSERT INTO pastes (id, user_id, text, lifetime, created, updated, password, public) VALUES (?, ?, ?, ?, ?, ?, ?, ?)"
-	statement, err := instance.db.Prepare(query)
+	statement, err := instance.db.Prepare(insertPasteQuery)
 	if err != nil {
 		return false, err
 	}
 	defer statement.Close()
 
-	res, err := statement.Exec(record.Id, record.UserId, record.Text, record.Lifetime, record.Created, record.Updated, record.Password, record.Public)
+	res, err := statement.Exec(record.Id, record.UserId, record.Title, record.Language, record.LanguageConfidence, record.ContentType, record.Text, record.Lifetime, record.Created, record.Updated, record.Password, record.Public, nullString(record.ForkedFrom), nullString(record.TeamId))
 	if err != nil {
 		return false, err
 	}
 	rowsAffected, _ := res.RowsAffected()
 	if rowsAffected > 0 {
+		record.Version = 1
 		return true, nil
 	}
 	return false, nil
 }
 
-func (instance *DBInstance) EditPasteRecord(record *typesDB.PasteRecord) error {
-	query := "UPDATE pastes SET user_id = ?, text = ?, lifetime = ?, created = ?, updated = ?, password = ?, public = ? WHERE id = ?"
-	statement, err := instance.db.Prepare(query)
+// AddPasteRecords сохраняет вставки вместе с их файлами (files[i] - файлы records[i])
+// одной транзакцией: при ошибке в базе не остаётся ни одной из них
+func (instance *DBInstance) AddPasteRecords(records []typesDB.PasteRecord, files [][]typesDB.PasteFileRecord) error {
+	tx, err := instance.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	pasteStatement, err := tx.Prepare(insertPasteQuery)
 	if err != nil {
 		return err
 	}
+	defer pasteStatement.Close()
+	fileStatement, err := tx.Prepare("INSERT INTO paste_files (paste_id, position, name, language, text) VALUES (?, ?, ?, ?, ?)")
+	if err != nil {
+		return err
+	}
+	defer fileStatement.Close()
+
+	for i := range records {
+		record := &records[i]
+		_, err := pasteStatement.Exec(record.Id, record.UserId, record.Title, record.Language, record.LanguageConfidence, record.ContentType, record.Text, record.Lifetime, record.Created, record.Updated, record.Password, record.Public, nullString(record.ForkedFrom), nullString(record.TeamId))
+		if err != nil {
+			return err
+		}
+		for j := range files[i] {
+			if _, err := fileStatement.Exec(record.Id, j, files[i][j].Name, files[i][j].Language, files[i][j].Text); err != nil {
+				return err
+			}
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	for i := range records {
+		records[i].Version = 1
+	}
+	return nil
+}
+
+// EditPasteRecord сохраняет вставку, только если её версия в базе всё ещё record.Version.
+// При успехе record.Version увеличивается, false означает, что вставку уже изменили
+func (instance *DBInstance) EditPasteRecord(record *typesDB.PasteRecord) (bool, error) {
+	query := "UPDATE pastes SET user_id = ?, title = ?, language = ?, language_confidence = ?, content_type = ?, text = ?, lifetime = ?, created = ?, updated = ?, password = ?, public = ?, version = version + 1 WHERE id = ? AND version = ?"
+	statement, err := instance.db.Prepare(query)
+	if err != nil {
+		return false, err
+	}
 	defer statement.Close()
 
-	_, err = statement.Exec(record.UserId, record.Text, record.Lifetime, record.Created, record.Updated, record.Password, record.Public, record.Id)
+	res, err := statement.Exec(record.UserId, record.Title, record.Language, record.LanguageConfidence, record.ContentType, record.Text, record.Lifetime, record.Created, record.Updated, record.Password, record.Public, record.Id, record.Version)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected > 0 {
+		record.Version++
+		return true, nil
+	}
+	return false, nil
+}
+
+// RehashPastePassword заменяет хеш пароля вставки, если его не сменили после проверки.
+// Содержимое не меняется, поэтому версия остаётся прежней
+func (instance *DBInstance) RehashPastePassword(id string, oldHash string, newHash string) error {
+	_, err := instance.db.Exec("UPDATE pastes SET password = ? WHERE id = ? AND password = ?", newHash, id, oldHash)
+	return err
+}
+
+// SetPasteLifetime меняет только время удаления вставки, версия при этом не растёт
+func (instance *DBInstance) SetPasteLifetime(pasteId string, lifetime int64) error {
+	_, err := instance.db.Exec("UPDATE pastes SET lifetime = ? WHERE id = ?", lifetime, pasteId)
 	return err
 }
 
@@ -318,6 +687,10 @@ func (instance *DBInstance) DeleteToken(token string) error {
 
 ///USERS, PASTES
 
+func nullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
+
 func (instance *DBInstance) DeleteRecord(id string, tableName string) error {
 	query := fmt.Sprintf("DELETE FROM %s WHERE id = ?", tableName)
 	_, err := instance.db.Exec(query, id)
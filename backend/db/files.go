@@ -0,0 +1,66 @@
+package db
+
+import (
+	"database/sql"
+	"pasteGo/backend/db/typesDB"
+)
+
+///PASTE FILES
+
+func (instance *DBInstance) GetPasteFiles(pasteId string) ([]typesDB.PasteFileRecord, error) {
+	query := "SELECT position, name, language, text FROM paste_files WHERE paste_id = ? ORDER BY position"
+	records := make([]typesDB.PasteFileRecord, 0)
+	rows, err := instance.db.Query(query, pasteId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		record := typesDB.PasteFileRecord{PasteId: pasteId}
+		if err := rows.Scan(&record.Position, &record.Name, &record.Language, &record.Text); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, rows.Err()
+}
+
+func (instance *DBInstance) GetPasteFile(pasteId string, name string) (typesDB.PasteFileRecord, bool, error) {
+	query := "SELECT position, language, text FROM paste_files WHERE paste_id = ? AND name = ?"
+	record := typesDB.PasteFileRecord{PasteId: pasteId, Name: name}
+	err := instance.db.QueryRow(query, pasteId, name).Scan(&record.Position, &record.Language, &record.Text)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return typesDB.PasteFileRecord{}, false, nil
+		}
+		return typesDB.PasteFileRecord{}, false, err
+	}
+	return record, true, nil
+}
+
+// SetPasteFiles целиком заменяет список файлов вставки, порядок задаётся порядком в срезе
+func (instance *DBInstance) SetPasteFiles(pasteId string, records []typesDB.PasteFileRecord) error {
+	tx, err := instance.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM paste_files WHERE paste_id = ?", pasteId); err != nil {
+		return err
+	}
+
+	statement, err := tx.Prepare("INSERT INTO paste_files (paste_id, position, name, language, text) VALUES (?, ?, ?, ?, ?)")
+	if err != nil {
+		return err
+	}
+	defer statement.Close()
+
+	for i := range records {
+		if _, err := statement.Exec(pasteId, i, records[i].Name, records[i].Language, records[i].Text); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
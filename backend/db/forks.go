@@ -0,0 +1,38 @@
+package db
+
+import (
+	"pasteGo/backend/db/typesDB"
+)
+
+///FORKS
+
+const maxForkDepth = 50
+
+// GetForkRecords возвращает всех потомков вставки, родитель узла - ForkedFrom
+func (instance *DBInstance) GetForkRecords(pasteId string) ([]typesDB.ForkRecord, error) {
+	query := `WITH RECURSIVE tree(id, depth) AS (
+			SELECT id, 1 FROM pastes WHERE forked_from = ?
+			UNION ALL
+			SELECT p.id, t.depth + 1 FROM pastes p JOIN tree t ON p.forked_from = t.id WHERE t.depth < ?
+		)
+		SELECT p.id, p.forked_from, p.user_id, u.username, p.title, p.created, p.lifetime, p.password != '', p.public, COALESCE(p.team_id, '')
+		FROM tree t
+		JOIN pastes p ON p.id = t.id
+		JOIN users u ON u.id = p.user_id
+		ORDER BY t.depth, p.created`
+	records := make([]typesDB.ForkRecord, 0)
+	rows, err := instance.db.Query(query, pasteId, maxForkDepth)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record typesDB.ForkRecord
+		if err := rows.Scan(&record.Id, &record.ForkedFrom, &record.UserId, &record.Username, &record.Title, &record.Created, &record.Lifetime, &record.HasPassword, &record.Public, &record.TeamId); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, rows.Err()
+}
@@ -0,0 +1,80 @@
+package db
+
+import (
+	"database/sql"
+	"pasteGo/backend/db/typesDB"
+	"pasteGo/backend/validation"
+)
+
+///IDENTITIES
+
+// GetUserRecordByIdentity находит пользователя, к которому привязана внешняя учётная запись
+func (instance *DBInstance) GetUserRecordByIdentity(provider string, subject string) (typesDB.UserRecord, bool, error) {
+	var userId string
+	err := instance.db.QueryRow("SELECT user_id FROM identities WHERE provider = ? AND subject = ?", provider, subject).Scan(&userId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return typesDB.UserRecord{}, false, nil
+		}
+		return typesDB.UserRecord{}, false, err
+	}
+	return instance.GetUserRecordById(userId)
+}
+
+func (instance *DBInstance) GetIdentityRecordsByUserId(userId string) ([]typesDB.IdentityRecord, error) {
+	rows, err := instance.db.Query("SELECT provider, subject, created FROM identities WHERE user_id = ? ORDER BY created", userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	records := make([]typesDB.IdentityRecord, 0)
+	for rows.Next() {
+		record := typesDB.IdentityRecord{UserId: userId}
+		if err := rows.Scan(&record.Provider, &record.Subject, &record.Created); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, rows.Err()
+}
+
+// AddIdentityRecord привязывает внешнюю учётную запись. false - она уже привязана
+func (instance *DBInstance) AddIdentityRecord(record *typesDB.IdentityRecord) (bool, error) {
+	res, err := instance.db.Exec("INSERT INTO identities (provider, subject, user_id, created) VALUES (?, ?, ?, ?) ON CONFLICT(provider, subject) DO NOTHING",
+		record.Provider, record.Subject, record.UserId, record.Created)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, _ := res.RowsAffected()
+	return rowsAffected > 0, nil
+}
+
+// AddUserRecordWithIdentity создаёт пользователя сразу с привязанной учётной записью.
+// false - имя пользователя занято или учётная запись уже привязана
+func (instance *DBInstance) AddUserRecordWithIdentity(user *typesDB.UserRecord, identity *typesDB.IdentityRecord) (bool, error) {
+	tx, err := instance.db.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	key := validation.UsernameKey(user.Username)
+	res, err := tx.Exec("INSERT INTO users (id, username, username_key, password) SELECT ?, ?, ?, ? WHERE NOT EXISTS (SELECT 1 FROM users WHERE username_key = ?) ON CONFLICT DO NOTHING",
+		user.Id, user.Username, key, user.Password, key)
+	if err != nil {
+		return false, err
+	}
+	if rowsAffected, _ := res.RowsAffected(); rowsAffected == 0 {
+		return false, nil
+	}
+	res, err = tx.Exec("INSERT INTO identities (provider, subject, user_id, created) VALUES (?, ?, ?, ?) ON CONFLICT(provider, subject) DO NOTHING",
+		identity.Provider, identity.Subject, user.Id, identity.Created)
+	if err != nil {
+		return false, err
+	}
+	if rowsAffected, _ := res.RowsAffected(); rowsAffected == 0 {
+		return false, nil
+	}
+	return true, tx.Commit()
+}
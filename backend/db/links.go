@@ -0,0 +1,83 @@
+package db
+
+import (
+	"database/sql"
+	"pasteGo/backend/db/typesDB"
+)
+
+///SHARE LINKS
+
+// AddShareLinkRecord сохраняет ссылку и заодно удаляет истёкшие ссылки всех вставок
+func (instance *DBInstance) AddShareLinkRecord(record *typesDB.ShareLinkRecord, now int64) error {
+	tx, err := instance.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM paste_links WHERE expires <= ?", now); err != nil {
+		return err
+	}
+	_, err = tx.Exec("INSERT INTO paste_links (id, paste_id, expires, max_views, views, created) VALUES (?, ?, ?, ?, 0, ?)",
+		record.Id, record.PasteId, record.Expires, record.MaxViews, record.Created)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// GetShareLinkRecords возвращает неистёкшие ссылки на вставку, в том числе исчерпавшие просмотры
+func (instance *DBInstance) GetShareLinkRecords(pasteId string, now int64) ([]typesDB.ShareLinkRecord, error) {
+	rows, err := instance.db.Query(`SELECT id, expires, max_views, views, created FROM paste_links
+		WHERE paste_id = ? AND expires > ?
+		ORDER BY created, id`, pasteId, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	records := make([]typesDB.ShareLinkRecord, 0)
+	for rows.Next() {
+		record := typesDB.ShareLinkRecord{PasteId: pasteId}
+		if err := rows.Scan(&record.Id, &record.Expires, &record.MaxViews, &record.Views, &record.Created); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, rows.Err()
+}
+
+func (instance *DBInstance) GetShareLinkRecord(id string) (typesDB.ShareLinkRecord, bool, error) {
+	record := typesDB.ShareLinkRecord{Id: id}
+	err := instance.db.QueryRow("SELECT paste_id, expires, max_views, views, created FROM paste_links WHERE id = ?", id).
+		Scan(&record.PasteId, &record.Expires, &record.MaxViews, &record.Views, &record.Created)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return typesDB.ShareLinkRecord{}, false, nil
+		}
+		return typesDB.ShareLinkRecord{}, false, err
+	}
+	return record, true, nil
+}
+
+// DeleteShareLinkRecord отзывает ссылку. false - у вставки нет такой ссылки
+func (instance *DBInstance) DeleteShareLinkRecord(pasteId string, id string) (bool, error) {
+	res, err := instance.db.Exec("DELETE FROM paste_links WHERE id = ? AND paste_id = ?", id, pasteId)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, _ := res.RowsAffected()
+	return rowsAffected > 0, nil
+}
+
+// UseShareLink засчитывает просмотр по ссылке. false - ссылку отозвали, её срок истёк
+// или просмотры кончились, в том числе из-за одновременного запроса
+func (instance *DBInstance) UseShareLink(id string, pasteId string, now int64) (bool, error) {
+	res, err := instance.db.Exec(`UPDATE paste_links SET views = views + 1
+		WHERE id = ? AND paste_id = ? AND expires > ? AND (max_views = 0 OR views < max_views)`, id, pasteId, now)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, _ := res.RowsAffected()
+	return rowsAffected > 0, nil
+}
@@ -0,0 +1,82 @@
+package db
+
+import (
+	"database/sql"
+	"pasteGo/backend/db/typesDB"
+)
+
+///PASSWORD RESETS
+
+// AddPasswordResetRecord сохраняет токен сброса. Прежние токены пользователя и
+// просроченные токены остальных удаляются: действует только последняя ссылка
+func (instance *DBInstance) AddPasswordResetRecord(record *typesDB.PasswordResetRecord, now int64) error {
+	tx, err := instance.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM password_resets WHERE user_id = ? OR expires <= ?", record.UserId, now); err != nil {
+		return err
+	}
+	if _, err := tx.Exec("INSERT INTO password_resets (token_hash, user_id, expires) VALUES (?, ?, ?)", record.TokenHash, record.UserId, record.Expires); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// GetPasswordResetRecord находит действующий токен сброса по хешу, не расходуя его
+func (instance *DBInstance) GetPasswordResetRecord(tokenHash string, now int64) (typesDB.PasswordResetRecord, bool, error) {
+	record := typesDB.PasswordResetRecord{TokenHash: tokenHash}
+	err := instance.db.QueryRow("SELECT user_id, expires FROM password_resets WHERE token_hash = ? AND expires > ?", tokenHash, now).Scan(&record.UserId, &record.Expires)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return typesDB.PasswordResetRecord{}, false, nil
+		}
+		return typesDB.PasswordResetRecord{}, false, err
+	}
+	return record, true, nil
+}
+
+// UsePasswordReset меняет пароль по токену сброса и отзывает все refresh токены пользователя.
+// Токен удаляется в любом случае. false - токена нет или его срок истёк
+func (instance *DBInstance) UsePasswordReset(tokenHash string, passwordHash string, now int64) (string, bool, error) {
+	tx, err := instance.db.Begin()
+	if err != nil {
+		return "", false, err
+	}
+	defer tx.Rollback()
+
+	var (
+		userId  string
+		expires int64
+	)
+	err = tx.QueryRow("SELECT user_id, expires FROM password_resets WHERE token_hash = ?", tokenHash).Scan(&userId, &expires)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	if _, err := tx.Exec("DELETE FROM password_resets WHERE token_hash = ?", tokenHash); err != nil {
+		return "", false, err
+	}
+	if expires <= now {
+		return "", false, tx.Commit()
+	}
+
+	res, err := tx.Exec("UPDATE users SET password = ? WHERE id = ? AND deleted = 0", passwordHash, userId)
+	if err != nil {
+		return "", false, err
+	}
+	if rowsAffected, _ := res.RowsAffected(); rowsAffected == 0 {
+		return "", false, tx.Commit()
+	}
+	if _, err := tx.Exec("DELETE FROM password_resets WHERE user_id = ?", userId); err != nil {
+		return "", false, err
+	}
+	if _, err := tx.Exec("DELETE FROM tokens WHERE user_id = ?", userId); err != nil {
+		return "", false, err
+	}
+	return userId, true, tx.Commit()
+}
@@ -0,0 +1,44 @@
+package db
+
+import (
+	"database/sql"
+	"pasteGo/backend/db/typesDB"
+)
+
+///QUOTAS
+
+func (instance *DBInstance) GetUsageByUserId(userId string) (typesDB.UsageRecord, error) {
+	query := `SELECT
+		(SELECT COUNT(*) FROM pastes WHERE user_id = ?1),
+		(SELECT COALESCE(SUM(LENGTH(CAST(text AS BLOB))), 0) FROM pastes WHERE user_id = ?1)
+		+ (SELECT COALESCE(SUM(LENGTH(CAST(f.text AS BLOB))), 0) FROM paste_files f JOIN pastes p ON p.id = f.paste_id WHERE p.user_id = ?1)
+		+ (SELECT COALESCE(SUM(a.size), 0) FROM attachments a JOIN pastes p ON p.id = a.paste_id WHERE p.user_id = ?1)`
+	record := typesDB.UsageRecord{}
+	err := instance.db.QueryRow(query, userId).Scan(&record.Pastes, &record.Storage)
+	return record, err
+}
+
+func (instance *DBInstance) GetQuotaRecord(userId string) (typesDB.QuotaRecord, bool, error) {
+	query := "SELECT max_pastes, max_storage FROM user_quotas WHERE user_id = ?"
+	record := typesDB.QuotaRecord{UserId: userId}
+	err := instance.db.QueryRow(query, userId).Scan(&record.MaxPastes, &record.MaxStorage)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return typesDB.QuotaRecord{UserId: userId}, false, nil
+		}
+		return typesDB.QuotaRecord{}, false, err
+	}
+	return record, true, nil
+}
+
+func (instance *DBInstance) SetQuotaRecord(record *typesDB.QuotaRecord) error {
+	query := `INSERT INTO user_quotas (user_id, max_pastes, max_storage) VALUES (?, ?, ?)
+		ON CONFLICT(user_id) DO UPDATE SET max_pastes = excluded.max_pastes, max_storage = excluded.max_storage`
+	_, err := instance.db.Exec(query, record.UserId, record.MaxPastes, record.MaxStorage)
+	return err
+}
+
+func (instance *DBInstance) DeleteQuotaRecord(userId string) error {
+	_, err := instance.db.Exec("DELETE FROM user_quotas WHERE user_id = ?", userId)
+	return err
+}
@@ -0,0 +1,183 @@
+package db
+
+import (
+	"errors"
+	"pasteGo/backend/db/typesDB"
+)
+
+///RETENTION
+
+var ErrLegalHold = errors.New("paste is under legal hold")
+
+// DeletePasteRecord удаляет вставку, если на неё не наложено удержание.
+// Для удерживаемой вставки возвращается ErrLegalHold
+func (instance *DBInstance) DeletePasteRecord(pasteId string) error {
+	res, err := instance.db.Exec("DELETE FROM pastes WHERE id = ? AND legal_hold = 0", pasteId)
+	if err != nil {
+		return err
+	}
+	if rowsAffected, _ := res.RowsAffected(); rowsAffected > 0 {
+		return nil
+	}
+	var held bool
+	err = instance.db.QueryRow("SELECT EXISTS(SELECT 1 FROM pastes WHERE id = ? AND legal_hold = 1)", pasteId).Scan(&held)
+	if err != nil {
+		return err
+	}
+	if held {
+		return ErrLegalHold
+	}
+	return nil
+}
+
+func (instance *DBInstance) SetPasteLegalHold(pasteId string, hold bool) (bool, error) {
+	res, err := instance.db.Exec("UPDATE pastes SET legal_hold = ? WHERE id = ?", typesDB.BoolToInt(hold), pasteId)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, _ := res.RowsAffected()
+	return rowsAffected > 0, nil
+}
+
+// MarkUserDeleted закрывает аккаунт, оставляя строку пользователя, пока у него есть вставки.
+// Имя освобождается, вход и обновление токенов становятся невозможны, пользователь выходит из команд
+func (instance *DBInstance) MarkUserDeleted(userId string, deleted int64) error {
+	tx, err := instance.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec("UPDATE users SET username = 'deleted-' || id, username_key = 'deleted-' || id, password = '', email = '', admin = 0, deleted = ? WHERE id = ?", deleted, userId)
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec("DELETE FROM tokens WHERE user_id = ?", userId); err != nil {
+		return err
+	}
+	if _, err = tx.Exec("DELETE FROM identities WHERE user_id = ?", userId); err != nil {
+		return err
+	}
+	if _, err = tx.Exec("DELETE FROM password_resets WHERE user_id = ?", userId); err != nil {
+		return err
+	}
+	if _, err = tx.Exec("DELETE FROM paste_shares WHERE user_id = ?", userId); err != nil {
+		return err
+	}
+	if _, err = tx.Exec("DELETE FROM user_groups WHERE user_id = ?", userId); err != nil {
+		return err
+	}
+	if err = leaveTeams(tx, userId); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (instance *DBInstance) GetDeletedUserRecords() ([]typesDB.UserRecord, error) {
+	rows, err := instance.db.Query("SELECT id, username, deleted FROM users WHERE deleted > 0")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	records := make([]typesDB.UserRecord, 0)
+	for rows.Next() {
+		var record typesDB.UserRecord
+		if err := rows.Scan(&record.Id, &record.Username, &record.Deleted); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, rows.Err()
+}
+
+// PurgeDeletedUser окончательно удаляет закрытый аккаунт, у которого не осталось вставок
+func (instance *DBInstance) PurgeDeletedUser(userId string) (bool, error) {
+	res, err := instance.db.Exec("DELETE FROM users WHERE id = ?1 AND deleted > 0 AND NOT EXISTS(SELECT 1 FROM pastes WHERE user_id = ?1)", userId)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, _ := res.RowsAffected()
+	return rowsAffected > 0, nil
+}
+
+// GetExpiredPasteRecords возвращает вставки с истёкшим сроком жизни без удержания
+func (instance *DBInstance) GetExpiredPasteRecords(now int64) (*[]typesDB.PasteRecord, error) {
+	query := "SELECT " + pasteColumns + " FROM pastes WHERE lifetime > 0 AND lifetime < ? AND legal_hold = 0"
+	return instance.queryPasteRecords(query, now)
+}
+
+// GetPasteRecordsCreatedBefore возвращает вставки без удержания, созданные раньше before.
+// public: 1 - только публичные, 0 - только приватные, -1 - все
+func (instance *DBInstance) GetPasteRecordsCreatedBefore(before int64, public int) (*[]typesDB.PasteRecord, error) {
+	query := "SELECT " + pasteColumns + " FROM pastes WHERE created < ? AND legal_hold = 0 AND (? < 0 OR public = ?)"
+	return instance.queryPasteRecords(query, before, public, public)
+}
+
+// GetRemovablePasteRecordsByUserId возвращает личные вставки пользователя без удержания.
+// Вставки команд принадлежат команде и переживают аккаунт автора
+func (instance *DBInstance) GetRemovablePasteRecordsByUserId(userId string) (*[]typesDB.PasteRecord, error) {
+	query := "SELECT " + pasteColumns + " FROM pastes WHERE user_id = ? AND legal_hold = 0 AND team_id IS NULL"
+	return instance.queryPasteRecords(query, userId)
+}
+
+func (instance *DBInstance) GetRetentionPolicyRecords() ([]typesDB.RetentionPolicyRecord, error) {
+	rows, err := instance.db.Query("SELECT id, scope, max_age, created FROM retention_policies ORDER BY created")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	records := make([]typesDB.RetentionPolicyRecord, 0)
+	for rows.Next() {
+		var record typesDB.RetentionPolicyRecord
+		if err := rows.Scan(&record.Id, &record.Scope, &record.MaxAge, &record.Created); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, rows.Err()
+}
+
+func (instance *DBInstance) AddRetentionPolicyRecord(record *typesDB.RetentionPolicyRecord) error {
+	_, err := instance.db.Exec("INSERT INTO retention_policies (id, scope, max_age, created) VALUES (?, ?, ?, ?)",
+		record.Id, record.Scope, record.MaxAge, record.Created)
+	return err
+}
+
+func (instance *DBInstance) DeleteRetentionPolicyRecord(id string) (bool, error) {
+	res, err := instance.db.Exec("DELETE FROM retention_policies WHERE id = ?", id)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, _ := res.RowsAffected()
+	return rowsAffected > 0, nil
+}
+
+func (instance *DBInstance) AddRetentionEventRecord(record *typesDB.RetentionEventRecord) error {
+	_, err := instance.db.Exec("INSERT INTO retention_events (time, action, policy_id, paste_id, user_id, actor, detail) VALUES (?, ?, ?, ?, ?, ?, ?)",
+		record.Time, record.Action, record.PolicyId, record.PasteId, record.UserId, record.Actor, record.Detail)
+	return err
+}
+
+// GetRetentionEventRecords возвращает последние limit событий, новые первыми.
+// Пустой pasteId не фильтрует по вставке
+func (instance *DBInstance) GetRetentionEventRecords(pasteId string, limit int) ([]typesDB.RetentionEventRecord, error) {
+	query := `SELECT id, time, action, policy_id, paste_id, user_id, actor, detail FROM retention_events
+		WHERE ?1 = '' OR paste_id = ?1 ORDER BY id DESC LIMIT ?2`
+	rows, err := instance.db.Query(query, pasteId, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	records := make([]typesDB.RetentionEventRecord, 0)
+	for rows.Next() {
+		var record typesDB.RetentionEventRecord
+		if err := rows.Scan(&record.Id, &record.Time, &record.Action, &record.PolicyId, &record.PasteId, &record.UserId, &record.Actor, &record.Detail); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, rows.Err()
+}
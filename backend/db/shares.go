@@ -0,0 +1,117 @@
+package db
+
+import (
+	"database/sql"
+	"pasteGo/backend/db/typesDB"
+)
+
+///SHARES
+
+func (instance *DBInstance) GetShareRecords(pasteId string) ([]typesDB.ShareRecord, error) {
+	rows, err := instance.db.Query(`SELECT s.id, IFNULL(s.user_id, ''), IFNULL(u.username, ''), s.group_name, s.permission, s.created
+		FROM paste_shares s
+		LEFT JOIN users u ON u.id = s.user_id
+		WHERE s.paste_id = ?
+		ORDER BY s.created, s.id`, pasteId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	records := make([]typesDB.ShareRecord, 0)
+	for rows.Next() {
+		record := typesDB.ShareRecord{PasteId: pasteId}
+		if err := rows.Scan(&record.Id, &record.UserId, &record.Username, &record.GroupName, &record.Permission, &record.Created); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, rows.Err()
+}
+
+// SetShareRecord выдаёт доступ. Если доступ этому пользователю или группе уже есть,
+// меняется только право, а record получает прежние Id и Created. true - доступ создан
+func (instance *DBInstance) SetShareRecord(record *typesDB.ShareRecord) (bool, error) {
+	tx, err := instance.db.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	var id string
+	var created int64
+	err = tx.QueryRow("SELECT id, created FROM paste_shares WHERE paste_id = ? AND IFNULL(user_id, '') = ? AND group_name = ?",
+		record.PasteId, record.UserId, record.GroupName).Scan(&id, &created)
+	switch {
+	case err == sql.ErrNoRows:
+		_, err = tx.Exec("INSERT INTO paste_shares (id, paste_id, user_id, group_name, permission, created) VALUES (?, ?, ?, ?, ?, ?)",
+			record.Id, record.PasteId, nullString(record.UserId), record.GroupName, record.Permission, record.Created)
+		if err != nil {
+			return false, err
+		}
+		return true, tx.Commit()
+	case err != nil:
+		return false, err
+	}
+
+	if _, err := tx.Exec("UPDATE paste_shares SET permission = ? WHERE id = ?", record.Permission, id); err != nil {
+		return false, err
+	}
+	record.Id, record.Created = id, created
+	return false, tx.Commit()
+}
+
+// DeleteShareRecord отзывает доступ. false - у вставки нет такого доступа
+func (instance *DBInstance) DeleteShareRecord(pasteId string, shareId string) (bool, error) {
+	res, err := instance.db.Exec("DELETE FROM paste_shares WHERE id = ? AND paste_id = ?", shareId, pasteId)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, _ := res.RowsAffected()
+	return rowsAffected > 0, nil
+}
+
+// GetPastePermission возвращает наибольшее право, выданное пользователю на вставку
+// лично или через группы: edit, read или пустую строку, если доступа нет.
+// Доступ через группу действует, если группа пришла при последнем входе пользователя
+func (instance *DBInstance) GetPastePermission(pasteId string, userId string) (string, error) {
+	var permission string
+	query := `SELECT permission FROM paste_shares
+		WHERE paste_id = ?1 AND (user_id = ?2 OR group_name IN (SELECT name FROM user_groups WHERE user_id = ?2))
+		ORDER BY permission = ?3 DESC LIMIT 1`
+	err := instance.db.QueryRow(query, pasteId, userId, typesDB.PermissionEdit).Scan(&permission)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return permission, err
+}
+
+// GetSharedPasteRecords возвращает чужие вставки, доступ к которым выдан пользователю
+func (instance *DBInstance) GetSharedPasteRecords(userId string) (*[]typesDB.PasteRecord, error) {
+	query := "SELECT " + pasteColumns + ` FROM pastes WHERE user_id != ?1 AND id IN (
+		SELECT paste_id FROM paste_shares WHERE user_id = ?1 OR group_name IN (SELECT name FROM user_groups WHERE user_id = ?1)
+	) ORDER BY created DESC`
+	return instance.queryPasteRecords(query, userId)
+}
+
+// SetUserGroups заменяет группы пользователя, полученные из source (ldap, oidc)
+func (instance *DBInstance) SetUserGroups(userId string, source string, groups []string) error {
+	tx, err := instance.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM user_groups WHERE user_id = ? AND source = ?", userId, source); err != nil {
+		return err
+	}
+	for _, group := range groups {
+		if group == "" {
+			continue
+		}
+		if _, err := tx.Exec("INSERT INTO user_groups (user_id, source, name) VALUES (?, ?, ?) ON CONFLICT DO NOTHING", userId, source, group); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,234 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"pasteGo/backend/db/typesDB"
+	"pasteGo/backend/validation"
+)
+
+///TEAMS
+
+var (
+	ErrTeamNotEmpty = errors.New("team still owns pastes")
+	ErrLastOwner    = errors.New("team must keep at least one owner")
+)
+
+// GetTeamRecordsByUserId возвращает команды пользователя с его ролью в каждой
+func (instance *DBInstance) GetTeamRecordsByUserId(userId string) ([]typesDB.TeamRecord, error) {
+	rows, err := instance.db.Query(`SELECT t.id, t.name, t.created, m.role
+		FROM team_members m
+		JOIN teams t ON t.id = m.team_id
+		WHERE m.user_id = ?
+		ORDER BY t.name_key`, userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	records := make([]typesDB.TeamRecord, 0)
+	for rows.Next() {
+		var record typesDB.TeamRecord
+		if err := rows.Scan(&record.Id, &record.Name, &record.Created, &record.Role); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, rows.Err()
+}
+
+func (instance *DBInstance) GetTeamRecordById(teamId string) (typesDB.TeamRecord, bool, error) {
+	record := typesDB.TeamRecord{Id: teamId}
+	err := instance.db.QueryRow("SELECT name, created FROM teams WHERE id = ?", teamId).Scan(&record.Name, &record.Created)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return typesDB.TeamRecord{}, false, nil
+		}
+		return typesDB.TeamRecord{}, false, err
+	}
+	return record, true, nil
+}
+
+// AddTeamRecord создаёт команду, ownerId становится её первым владельцем.
+// false - имя уже занято (без учёта регистра и формы Unicode)
+func (instance *DBInstance) AddTeamRecord(record *typesDB.TeamRecord, ownerId string) (bool, error) {
+	tx, err := instance.db.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec("INSERT INTO teams (id, name, name_key, created) VALUES (?, ?, ?, ?) ON CONFLICT DO NOTHING",
+		record.Id, record.Name, validation.TeamKey(record.Name), record.Created)
+	if err != nil {
+		return false, err
+	}
+	if rowsAffected, _ := res.RowsAffected(); rowsAffected == 0 {
+		return false, nil
+	}
+	_, err = tx.Exec("INSERT INTO team_members (team_id, user_id, role, joined) VALUES (?, ?, ?, ?)",
+		record.Id, ownerId, typesDB.TeamRoleOwner, record.Created)
+	if err != nil {
+		return false, err
+	}
+	record.Role = typesDB.TeamRoleOwner
+	return true, tx.Commit()
+}
+
+// DeleteTeamRecord удаляет команду вместе с составом. Команду, у которой есть вставки,
+// не удалить: возвращается ErrTeamNotEmpty
+func (instance *DBInstance) DeleteTeamRecord(teamId string) (bool, error) {
+	tx, err := instance.db.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	var owns bool
+	if err := tx.QueryRow("SELECT EXISTS(SELECT 1 FROM pastes WHERE team_id = ?)", teamId).Scan(&owns); err != nil {
+		return false, err
+	}
+	if owns {
+		return false, ErrTeamNotEmpty
+	}
+	res, err := tx.Exec("DELETE FROM teams WHERE id = ?", teamId)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, _ := res.RowsAffected()
+	return rowsAffected > 0, tx.Commit()
+}
+
+func (instance *DBInstance) GetTeamMemberRecords(teamId string) ([]typesDB.TeamMemberRecord, error) {
+	rows, err := instance.db.Query(`SELECT m.user_id, u.username, m.role, m.joined
+		FROM team_members m
+		JOIN users u ON u.id = m.user_id
+		WHERE m.team_id = ?
+		ORDER BY m.joined, u.username`, teamId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	records := make([]typesDB.TeamMemberRecord, 0)
+	for rows.Next() {
+		record := typesDB.TeamMemberRecord{TeamId: teamId}
+		if err := rows.Scan(&record.UserId, &record.Username, &record.Role, &record.Joined); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, rows.Err()
+}
+
+// GetTeamRole возвращает роль пользователя в команде или пустую строку, если он не состоит в ней
+func (instance *DBInstance) GetTeamRole(teamId string, userId string) (string, error) {
+	var role string
+	err := instance.db.QueryRow("SELECT role FROM team_members WHERE team_id = ? AND user_id = ?", teamId, userId).Scan(&role)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return role, err
+}
+
+// SetTeamMember добавляет участника или меняет его роль, record получает прежнее время
+// вступления. Последнего владельца не понизить: ErrLastOwner. true - участник добавлен
+func (instance *DBInstance) SetTeamMember(record *typesDB.TeamMemberRecord) (bool, error) {
+	tx, err := instance.db.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	var role string
+	var joined int64
+	err = tx.QueryRow("SELECT role, joined FROM team_members WHERE team_id = ? AND user_id = ?", record.TeamId, record.UserId).Scan(&role, &joined)
+	switch {
+	case err == sql.ErrNoRows:
+		_, err = tx.Exec("INSERT INTO team_members (team_id, user_id, role, joined) VALUES (?, ?, ?, ?)",
+			record.TeamId, record.UserId, record.Role, record.Joined)
+		if err != nil {
+			return false, err
+		}
+		return true, tx.Commit()
+	case err != nil:
+		return false, err
+	}
+
+	if role == typesDB.TeamRoleOwner && record.Role != typesDB.TeamRoleOwner {
+		if err := checkOtherOwner(tx, record.TeamId, record.UserId); err != nil {
+			return false, err
+		}
+	}
+	if _, err := tx.Exec("UPDATE team_members SET role = ? WHERE team_id = ? AND user_id = ?", record.Role, record.TeamId, record.UserId); err != nil {
+		return false, err
+	}
+	record.Joined = joined
+	return false, tx.Commit()
+}
+
+// DeleteTeamMember исключает участника из команды. Последний владелец не может уйти:
+// ErrLastOwner. false - пользователь не состоит в команде
+func (instance *DBInstance) DeleteTeamMember(teamId string, userId string) (bool, error) {
+	tx, err := instance.db.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	var role string
+	err = tx.QueryRow("SELECT role FROM team_members WHERE team_id = ? AND user_id = ?", teamId, userId).Scan(&role)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+	if role == typesDB.TeamRoleOwner {
+		if err := checkOtherOwner(tx, teamId, userId); err != nil {
+			return false, err
+		}
+	}
+	if _, err := tx.Exec("DELETE FROM team_members WHERE team_id = ? AND user_id = ?", teamId, userId); err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+// GetTeamPasteRecords возвращает вставки команды, новые первыми
+func (instance *DBInstance) GetTeamPasteRecords(teamId string) (*[]typesDB.PasteRecord, error) {
+	query := "SELECT " + pasteColumns + " FROM pastes WHERE team_id = ? ORDER BY created DESC"
+	return instance.queryPasteRecords(query, teamId)
+}
+
+func checkOtherOwner(tx *sql.Tx, teamId string, userId string) error {
+	var exists bool
+	err := tx.QueryRow("SELECT EXISTS(SELECT 1 FROM team_members WHERE team_id = ? AND user_id != ? AND role = ?)",
+		teamId, userId, typesDB.TeamRoleOwner).Scan(&exists)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrLastOwner
+	}
+	return nil
+}
+
+// leaveTeams выводит закрываемый аккаунт из всех команд. Если он был последним владельцем,
+// владельцем становится участник, вступивший раньше остальных: вставки команды не остаются без хозяина
+func leaveTeams(tx *sql.Tx, userId string) error {
+	_, err := tx.Exec(`UPDATE team_members SET role = ?1
+		WHERE user_id = (
+			SELECT o.user_id FROM team_members o
+			WHERE o.team_id = team_members.team_id AND o.user_id != ?2
+			ORDER BY o.joined, o.user_id LIMIT 1
+		)
+		AND team_id IN (SELECT team_id FROM team_members WHERE user_id = ?2 AND role = ?1)
+		AND NOT EXISTS(SELECT 1 FROM team_members x WHERE x.team_id = team_members.team_id AND x.user_id != ?2 AND x.role = ?1)`,
+		typesDB.TeamRoleOwner, userId)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec("DELETE FROM team_members WHERE user_id = ?", userId)
+	return err
+}
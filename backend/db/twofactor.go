@@ -0,0 +1,101 @@
+package db
+
+import "database/sql"
+
+///TWO-FACTOR
+
+// SetPendingTotpSecret сохраняет секрет, который ещё нужно подтвердить кодом.
+// Если двухфакторный вход уже включён, ничего не меняет и возвращает false
+func (instance *DBInstance) SetPendingTotpSecret(userId string, secret string) (bool, error) {
+	res, err := instance.db.Exec("UPDATE users SET totp_secret = ?, totp_last_step = 0 WHERE id = ? AND totp_enabled = 0", secret, userId)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, _ := res.RowsAffected()
+	return rowsAffected > 0, nil
+}
+
+// EnableTotp включает двухфакторный вход и заменяет коды восстановления
+func (instance *DBInstance) EnableTotp(userId string, step int64, codeHashes []string) error {
+	tx, err := instance.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("UPDATE users SET totp_enabled = 1, totp_last_step = ? WHERE id = ?", step, userId); err != nil {
+		return err
+	}
+	if err := replaceRecoveryCodes(tx, userId, codeHashes); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (instance *DBInstance) DisableTotp(userId string) error {
+	tx, err := instance.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("UPDATE users SET totp_secret = '', totp_enabled = 0, totp_last_step = 0 WHERE id = ?", userId); err != nil {
+		return err
+	}
+	if _, err := tx.Exec("DELETE FROM recovery_codes WHERE user_id = ?", userId); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// UseTotpStep запоминает шаг принятого кода. false - код этого или более позднего шага
+// уже был принят, то есть это повтор
+func (instance *DBInstance) UseTotpStep(userId string, step int64) (bool, error) {
+	res, err := instance.db.Exec("UPDATE users SET totp_last_step = ?1 WHERE id = ?2 AND totp_last_step < ?1", step, userId)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, _ := res.RowsAffected()
+	return rowsAffected > 0, nil
+}
+
+func (instance *DBInstance) ReplaceRecoveryCodes(userId string, codeHashes []string) error {
+	tx, err := instance.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := replaceRecoveryCodes(tx, userId, codeHashes); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// UseRecoveryCode удаляет код восстановления. false - такого кода нет
+func (instance *DBInstance) UseRecoveryCode(userId string, codeHash string) (bool, error) {
+	res, err := instance.db.Exec("DELETE FROM recovery_codes WHERE user_id = ? AND code_hash = ?", userId, codeHash)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, _ := res.RowsAffected()
+	return rowsAffected > 0, nil
+}
+
+func (instance *DBInstance) CountRecoveryCodes(userId string) (int, error) {
+	var count int
+	err := instance.db.QueryRow("SELECT COUNT(*) FROM recovery_codes WHERE user_id = ?", userId).Scan(&count)
+	return count, err
+}
+
+func replaceRecoveryCodes(tx *sql.Tx, userId string, codeHashes []string) error {
+	if _, err := tx.Exec("DELETE FROM recovery_codes WHERE user_id = ?", userId); err != nil {
+		return err
+	}
+	for _, hash := range codeHashes {
+		if _, err := tx.Exec("INSERT INTO recovery_codes (user_id, code_hash) VALUES (?, ?)", userId, hash); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,20 +1,171 @@
 package typesDB
 
+import "database/sql"
+
 type UserRecord struct {
 	Id       string //UUID
 	Username string
 	Password string
+	Admin    int
+	Deleted  int64  //Время удаления аккаунта, 0 - активен
+	Email    string //Адрес для сброса пароля, пусто - не указан
+
+	TotpSecret   string //Секрет TOTP, при TotpEnabled = 0 - ещё не подтверждённый
+	TotpEnabled  int
+	TotpLastStep int64 //Шаг последнего принятого кода, повторно его не принять
+}
+
+// IdentityRecord - учётная запись внешнего провайдера (OIDC), привязанная к пользователю
+type IdentityRecord struct {
+	Provider string //Issuer провайдера
+	Subject  string //Claim sub
+	UserId   string
+	Created  int64
+}
+
+// PasswordResetRecord - одноразовый токен сброса пароля, хранится только его хеш
+type PasswordResetRecord struct {
+	TokenHash string
+	UserId    string
+	Expires   int64
 }
 
 type PasteRecord struct {
-	Id       string //UUID
+	Id                 string //UUID
+	UserId             string
+	Title              string
+	Language           string
+	LanguageConfidence float64
+	ContentType        string
+	Text               string
+	Created            int64
+	Updated            int64
+	Lifetime           int64
+	Password           string
+	Public             int
+	ForkedFrom         string //Id исходной вставки или пустая строка
+	Version            int64  //Растёт на 1 при каждом изменении
+	LegalHold          int    //1 - вставку нельзя удалить
+	TeamId             string //Команда-владелец или пустая строка для личной вставки
+}
+
+// ShareRecord - доступ к вставке: UserId для пользователя или GroupName для группы
+type ShareRecord struct {
+	Id         string //UUID
+	PasteId    string
+	UserId     string
+	Username   string //Заполняется при чтении
+	GroupName  string
+	Permission string
+	Created    int64
+}
+
+// TeamRecord - команда. Role заполняется при чтении списка команд пользователя
+type TeamRecord struct {
+	Id      string //UUID
+	Name    string
+	Created int64
+	Role    string
+}
+
+type TeamMemberRecord struct {
+	TeamId   string
 	UserId   string
-	Text     string
+	Username string //Заполняется при чтении
+	Role     string
+	Joined   int64
+}
+
+// ShareLinkRecord - подписанная ссылка на вставку с ограниченным сроком и числом просмотров
+type ShareLinkRecord struct {
+	Id       string //UUID, он же jti токена ссылки
+	PasteId  string
+	Expires  int64
+	MaxViews int64 //0 - без ограничения
+	Views    int64
 	Created  int64
-	Updated  int64
-	Lifetime int64
-	Password string
-	Public   int
+}
+
+type PasteFileRecord struct {
+	PasteId  string
+	Position int
+	Name     string
+	Language string
+	Text     string
+}
+
+type AttachmentRecord struct {
+	Id          string //UUID, он же ключ в хранилище блобов
+	PasteId     string
+	Name        string
+	ContentType string
+	Size        int64
+	Created     int64
+}
+
+// QuotaRecord - индивидуальные лимиты пользователя, NULL означает лимит по умолчанию
+type QuotaRecord struct {
+	UserId     string
+	MaxPastes  sql.NullInt64
+	MaxStorage sql.NullInt64
+}
+
+type UsageRecord struct {
+	Pastes  int64
+	Storage int64 //Байты текста, файлов и вложений
+}
+
+// ForkRecord - узел дерева форков без текста вставки
+type ForkRecord struct {
+	Id          string
+	ForkedFrom  string
+	UserId      string
+	Username    string
+	Title       string
+	Created     int64
+	Lifetime    int64
+	HasPassword int
+	Public      int
+	TeamId      string //Пусто - вставка не принадлежит команде
+}
+
+type RetentionPolicyRecord struct {
+	Id      string
+	Scope   string
+	MaxAge  string //ISO-8601 длительность
+	Created int64
+}
+
+type RetentionEventRecord struct {
+	Id       int64
+	Time     int64
+	Action   string
+	PolicyId string
+	PasteId  string
+	UserId   string
+	Actor    string
+	Detail   string
+}
+
+type AuditEventRecord struct {
+	Id        int64
+	Time      int64
+	Actor     string
+	Action    string
+	Target    string //Вид и id объекта: user:<id>, paste:<id>, team:<id>, policy:<id>
+	Ip        string
+	UserAgent string
+	Detail    string
+}
+
+// AuditFilter - условия выборки журнала аудита, пустые поля не фильтруют
+type AuditFilter struct {
+	Actor  string
+	Action string
+	Target string
+	Since  int64
+	Until  int64
+	Limit  int //0 - без ограничения
 }
 
 type TokenRecord struct {
@@ -23,8 +174,43 @@ type TokenRecord struct {
 }
 
 const (
-	UsersTable  = "users"
-	PastesTable = "pastes"
+	UsersTable       = "users"
+	PastesTable      = "pastes"
+	AttachmentsTable = "attachments"
+)
+
+// Область действия политики хранения
+const (
+	RetentionScopeAll          = "all"
+	RetentionScopePublic       = "public"
+	RetentionScopePrivate      = "private"
+	RetentionScopeDeletedUsers = "deleted_users"
+)
+
+// Кому выдан доступ к вставке
+const (
+	ShareTypeUser  = "user"
+	ShareTypeGroup = "group"
+)
+
+// Права на вставку. Owner не хранится в paste_shares: это автор вставки
+const (
+	PermissionRead  = "read"
+	PermissionEdit  = "edit"
+	PermissionOwner = "owner"
+)
+
+// Роли в команде: owner управляет составом, editor создаёт и меняет вставки команды, viewer читает их
+const (
+	TeamRoleOwner  = "owner"
+	TeamRoleEditor = "editor"
+	TeamRoleViewer = "viewer"
+)
+
+// Тип содержимого вставки: обычный текст/код или markdown-документ
+const (
+	ContentTypeText     = "text"
+	ContentTypeMarkdown = "markdown"
 )
 
 func BoolToInt(b bool) int {
@@ -0,0 +1,118 @@
+package detect
+
+import (
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/alecthomas/chroma/v2"
+	"github.com/alecthomas/chroma/v2/lexers"
+)
+
+// Уверенность каждого способа определения. Явно указанный пользователем язык - 1
+const (
+	ConfidenceUser     = 1.0
+	ConfidenceShebang  = 0.95
+	ConfidenceFilename = 0.9
+	//Эвристики по содержимому никогда не уверены больше, чем имя файла
+	maxContentConfidence = 0.85
+	minContentScore      = 0.3
+)
+
+// Result - определённый язык (имя лексера chroma в нижнем регистре) и уверенность от 0 до 1.
+// Пустой Language означает, что язык определить не удалось
+type Result struct {
+	Language   string
+	Confidence float64
+}
+
+// Detect угадывает язык текста: сначала по shebang, затем по имени файла,
+// затем по эвристикам содержимого
+func Detect(filename string, text string) Result {
+	if language := shebang(text); language != "" {
+		return Result{Language: Normalize(language), Confidence: ConfidenceShebang}
+	}
+	if filename != "" {
+		if lexer := lexers.Match(path.Base(filename)); lexer != nil && !isPlaintext(lexer) {
+			return Result{Language: name(lexer), Confidence: ConfidenceFilename}
+		}
+	}
+	return content(text)
+}
+
+// Normalize приводит имя или псевдоним языка к имени лексера, неизвестные имена
+// возвращаются в нижнем регистре как есть
+func Normalize(language string) string {
+	language = strings.TrimSpace(language)
+	if language == "" {
+		return ""
+	}
+	if lexer := lexers.Get(language); lexer != nil {
+		return name(lexer)
+	}
+	return strings.ToLower(language)
+}
+
+func name(lexer chroma.Lexer) string {
+	return strings.ToLower(lexer.Config().Name)
+}
+
+func isPlaintext(lexer chroma.Lexer) bool {
+	switch name(lexer) {
+	case "plaintext", "fallback":
+		return true
+	}
+	return false
+}
+
+var interpreters = map[string]string{
+	"sh":      "bash",
+	"bash":    "bash",
+	"zsh":     "bash",
+	"ksh":     "bash",
+	"dash":    "bash",
+	"fish":    "fish",
+	"python":  "python",
+	"pypy":    "python",
+	"node":    "javascript",
+	"nodejs":  "javascript",
+	"deno":    "typescript",
+	"bun":     "javascript",
+	"ruby":    "ruby",
+	"perl":    "perl",
+	"php":     "php",
+	"lua":     "lua",
+	"tclsh":   "tcl",
+	"rscript": "r",
+	"pwsh":    "powershell",
+	"awk":     "awk",
+	"gawk":    "awk",
+	"make":    "makefile",
+}
+
+var versionSuffix = regexp.MustCompile(`[0-9.]+$`)
+
+// shebang разбирает #!/usr/bin/env python3 и #!/bin/sh -e
+func shebang(text string) string {
+	if !strings.HasPrefix(text, "#!") {
+		return ""
+	}
+	line, _, _ := strings.Cut(text[2:], "\n")
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return ""
+	}
+
+	interpreter := path.Base(fields[0])
+	if interpreter == "env" {
+		interpreter = ""
+		for _, field := range fields[1:] {
+			if !strings.HasPrefix(field, "-") && !strings.Contains(field, "=") {
+				interpreter = path.Base(field)
+				break
+			}
+		}
+	}
+	interpreter = strings.ToLower(versionSuffix.ReplaceAllString(interpreter, ""))
+	return interpreters[interpreter]
+}
@@ -0,0 +1,137 @@
+package detect
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+// rule - признак языка в тексте; веса совпавших признаков складываются
+type rule struct {
+	language string
+	pattern  *regexp.Regexp
+	weight   float64
+}
+
+func r(language string, pattern string, weight float64) rule {
+	return rule{language: language, pattern: regexp.MustCompile(pattern), weight: weight}
+}
+
+// Анализируется только начало текста, чтобы большие вставки не замедляли создание
+const maxAnalysedBytes = 64 << 10
+
+var rules = []rule{
+	r("go", `(?m)^package \w+\s*$`, 0.4),
+	r("go", `(?m)^func (\(\w+ \*?\w+\) )?\w+\(`, 0.3),
+	r("go", `(?m)^import \($`, 0.3),
+	r("go", `\w+ := `, 0.1),
+
+	r("python", `(?m)^\s*def \w+\(.*\)( -> .+)?:\s*$`, 0.4),
+	r("python", `(?m)^(from [\w.]+ )?import [\w., ]+$`, 0.15),
+	r("python", `(?m)^class \w+(\(.*\))?:\s*$`, 0.3),
+	r("python", `if __name__ == ['"]__main__['"]`, 0.5),
+	r("python", `\bself\.\w+`, 0.1),
+
+	r("javascript", `\b(const|let|var) \w+ = require\(`, 0.4),
+	r("javascript", `\bconsole\.log\(`, 0.3),
+	r("javascript", `\bfunction\s*\w*\s*\(`, 0.2),
+	r("javascript", `\bexport (default|const|function|class)\b`, 0.2),
+	r("javascript", `\b(document|window)\.\w+`, 0.2),
+	r("javascript", `\) => \{`, 0.1),
+
+	r("typescript", `(?m)^(export )?interface \w+ \{`, 0.4),
+	r("typescript", `\w+\??: (string|number|boolean|any|void)\b`, 0.3),
+	r("typescript", `(?m)^import .+ from ['"].+['"];?$`, 0.1),
+
+	r("java", `\bpublic (static )?(final )?(class|void|interface)\b`, 0.4),
+	r("java", `System\.out\.println\(`, 0.5),
+	r("java", `(?m)^import java\.`, 0.5),
+
+	r("c", `(?m)^#include <\w+\.h>`, 0.5),
+	r("c", `\bint main\(`, 0.2),
+	r("c", `\bprintf\(`, 0.2),
+
+	r("c++", `(?m)^#include <(iostream|vector|string|map|memory|algorithm)>`, 0.6),
+	r("c++", `\bstd::`, 0.4),
+
+	r("c#", `(?m)^using System(\.[\w.]+)?;`, 0.5),
+	r("c#", `\bConsole\.WriteLine\(`, 0.5),
+
+	r("rust", `(?m)^\s*(pub )?fn \w+(<.*>)?\(`, 0.3),
+	r("rust", `\blet mut\b`, 0.4),
+	r("rust", `\bprintln!\(`, 0.4),
+	r("rust", `(?m)^use \w+(::\w+)+`, 0.3),
+
+	r("php", `<\?php`, 1.0),
+
+	r("ruby", `(?m)^\s*require ['"]\w+['"]$`, 0.2),
+	r("ruby", `(?m)^\s*end$`, 0.2),
+	r("ruby", `(?m)^\s*puts `, 0.2),
+	r("ruby", `(?m)^\s*def \w+[?!]?(\(.*\))?$`, 0.2),
+
+	r("bash", `(?m)^\s*(fi|esac|done)$`, 0.3),
+	r("bash", `(?m)^\s*(export \w+=|echo )`, 0.2),
+	r("bash", `(?m)^\s*if \[\[? `, 0.3),
+	r("bash", `\$\{\w+[:#%/]?.*\}`, 0.1),
+
+	r("sql", `(?im)^\s*(select .+ from|insert into|create table|alter table|update \w+ set|delete from)\b`, 0.6),
+
+	r("html", `(?i)<!doctype html|<html[\s>]`, 0.8),
+	r("html", `(?i)<(div|span|body|head|script|p)[\s>]`, 0.3),
+
+	r("xml", `^\s*<\?xml `, 0.9),
+
+	r("css", `(?m)^[.#]?[\w-]+(\s*[,>]\s*[.#]?[\w-]+)*\s*\{\s*$`, 0.2),
+	r("css", `(?m)^\s*(color|margin|padding|display|font-[\w-]+|background(-[\w-]+)?):\s*[^;]+;\s*$`, 0.3),
+
+	r("yaml", `(?m)^---\s*$`, 0.3),
+	r("yaml", `(?m)^[\w-]+:\s*$`, 0.15),
+	r("yaml", `(?m)^\s+- [\w"']`, 0.1),
+	r("yaml", `(?m)^\s+[\w-]+: \S`, 0.1),
+
+	r("markdown", `(?m)^#{1,6} \S`, 0.3),
+	r("markdown", "(?m)^```", 0.3),
+	r("markdown", `\[[^\]]+\]\([^)]+\)`, 0.2),
+
+	r("docker", `(?m)^FROM \S+`, 0.5),
+	r("docker", `(?m)^(RUN|COPY|CMD|ENTRYPOINT|WORKDIR|EXPOSE) `, 0.3),
+
+	r("toml", `(?m)^\[[\w.-]+\]\s*$`, 0.3),
+	r("toml", `(?m)^[\w-]+ = ("|\d|\[|true|false)`, 0.2),
+}
+
+// content оценивает текст по правилам и выбирает язык с наибольшим весом
+func content(text string) Result {
+	if len(text) > maxAnalysedBytes {
+		text = text[:maxAnalysedBytes]
+	}
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		return Result{}
+	}
+	if (trimmed[0] == '{' || trimmed[0] == '[') && json.Valid([]byte(trimmed)) {
+		return Result{Language: "json", Confidence: maxContentConfidence}
+	}
+
+	scores := make(map[string]float64)
+	for i := range rules {
+		if rules[i].pattern.MatchString(text) {
+			scores[rules[i].language] += rules[i].weight
+		}
+	}
+
+	best := Result{}
+	for language, score := range scores {
+		if score > best.Confidence || (score == best.Confidence && language < best.Language) {
+			best = Result{Language: language, Confidence: score}
+		}
+	}
+	if best.Confidence < minContentScore {
+		return Result{}
+	}
+	if best.Confidence > maxContentConfidence {
+		best.Confidence = maxContentConfidence
+	}
+	best.Language = Normalize(best.Language)
+	return best
+}
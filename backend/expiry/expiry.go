@@ -0,0 +1,213 @@
+// Package expiry переводит срок жизни вставки из запроса в unix время удаления.
+// Поддерживаются старые названия (day, week...), ISO-8601 длительности (P90D, PT12H)
+// и абсолютное время RFC 3339
+package expiry
+
+import (
+	"errors"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Never - вставка хранится бессрочно
+const Never int64 = -1
+
+var (
+	ErrFormat  = errors.New("unknown lifetime format")
+	ErrPast    = errors.New("expiry time is in the past")
+	ErrTooLong = errors.New("lifetime exceeds the maximum")
+)
+
+// Названия сроков, которые принимал API до ISO-8601
+var presets = map[string]Duration{
+	"minute": {Clock: time.Minute},
+	"hour":   {Clock: time.Hour},
+	"day":    {Days: 1},
+	"week":   {Days: 7},
+	"month":  {Days: 30},
+	"year":   {Days: 365},
+}
+
+var isoDuration = regexp.MustCompile(`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:[.,]\d+)?)S)?)?$`)
+
+// Duration - календарная длительность: годы, месяцы и дни прибавляются по календарю
+type Duration struct {
+	Years  int
+	Months int
+	Days   int
+	Clock  time.Duration
+}
+
+// AddTo возвращает t, сдвинутое на d
+func (d Duration) AddTo(t time.Time) time.Time {
+	return t.AddDate(d.Years, d.Months, d.Days).Add(d.Clock)
+}
+
+// SubtractFrom возвращает t, сдвинутое на d назад
+func (d Duration) SubtractFrom(t time.Time) time.Time {
+	return t.AddDate(-d.Years, -d.Months, -d.Days).Add(-d.Clock)
+}
+
+// maximum - наибольший срок жизни, nil - без ограничения
+var (
+	maximum     *Duration
+	maximumText string
+)
+
+// SetMaximum задаёт наибольший срок жизни из MAX_PASTE_LIFETIME. Пустая строка снимает ограничение
+func SetMaximum(value string) error {
+	if value == "" {
+		maximum, maximumText = nil, ""
+		return nil
+	}
+	duration, err := ParseDuration(value)
+	if err != nil {
+		return err
+	}
+	maximum, maximumText = &duration, value
+	return nil
+}
+
+// Maximum возвращает наибольший срок жизни в том виде, в каком он задан, или пустую строку
+func Maximum() string {
+	return maximumText
+}
+
+// ParseDuration разбирает название срока или ISO-8601 длительность вида PnYnMnWnDTnHnMnS
+func ParseDuration(value string) (Duration, error) {
+	if preset, ok := presets[strings.ToLower(value)]; ok {
+		return preset, nil
+	}
+
+	value = strings.ToUpper(value)
+	match := isoDuration.FindStringSubmatch(value)
+	if match == nil || value == "P" || strings.HasSuffix(value, "T") {
+		return Duration{}, ErrFormat
+	}
+	var numbers [7]int64
+	for i := 1; i <= 6; i++ {
+		if match[i] == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(match[i], 10, 0)
+		if err != nil {
+			return Duration{}, ErrFormat
+		}
+		numbers[i] = n
+	}
+
+	//Каждое слагаемое проверяется на переполнение до умножения
+	days, ok := mulAdd(numbers[3], 7, numbers[4])
+	if !ok {
+		return Duration{}, ErrFormat
+	}
+	clock, ok := mulAdd(numbers[5], int64(time.Hour), 0)
+	if ok {
+		clock, ok = mulAdd(numbers[6], int64(time.Minute), clock)
+	}
+	if !ok {
+		return Duration{}, ErrFormat
+	}
+	if match[7] != "" {
+		seconds, err := strconv.ParseFloat(strings.Replace(match[7], ",", ".", 1), 64)
+		if err != nil || seconds >= float64(math.MaxInt64-clock)/float64(time.Second) {
+			return Duration{}, ErrFormat
+		}
+		clock += int64(seconds * float64(time.Second))
+	}
+
+	//Верхняя оценка в секундах: AddDate молча переполняется на слишком больших значениях
+	const day = 24 * 60 * 60
+	total, ok := mulAdd(numbers[1], 366*day, clock/int64(time.Second))
+	if ok {
+		total, ok = mulAdd(numbers[2], 31*day, total)
+	}
+	if ok {
+		_, ok = mulAdd(days, day, total)
+	}
+	if !ok {
+		return Duration{}, ErrFormat
+	}
+
+	duration := Duration{
+		Years:  int(numbers[1]),
+		Months: int(numbers[2]),
+		Days:   int(days),
+		Clock:  time.Duration(clock),
+	}
+	if duration.AddTo(time.Unix(0, 0)).Unix() <= 0 {
+		return Duration{}, ErrFormat
+	}
+	return duration, nil
+}
+
+// Parse переводит срок жизни в unix время удаления.
+// Пустое значение - срок по умолчанию, forever и never - бессрочно
+func Parse(value string, now time.Time) (int64, error) {
+	value = strings.TrimSpace(value)
+	switch strings.ToLower(value) {
+	case "":
+		return Default(now), nil
+	case "forever", "never":
+		if maximum != nil {
+			return 0, ErrTooLong
+		}
+		return Never, nil
+	}
+
+	if absolute, err := time.Parse(time.RFC3339, value); err == nil {
+		if !absolute.After(now) {
+			return 0, ErrPast
+		}
+		return check(absolute, now)
+	}
+
+	duration, err := ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	return check(duration.AddTo(now), now)
+}
+
+// Extend продлевает вставку, которая удаляется в expires, на длительность value.
+// Бессрочная вставка остаётся бессрочной
+func Extend(expires int64, value string, now time.Time) (int64, error) {
+	duration, err := ParseDuration(strings.TrimSpace(value))
+	if err != nil {
+		return 0, err
+	}
+	if expires == Never {
+		return Never, nil
+	}
+	from := time.Unix(expires, 0)
+	if from.Before(now) {
+		from = now
+	}
+	return check(duration.AddTo(from), now)
+}
+
+// Default - время удаления, когда клиент не указал срок: бессрочно или наибольший срок
+func Default(now time.Time) int64 {
+	if maximum == nil {
+		return Never
+	}
+	return maximum.AddTo(now).Unix()
+}
+
+// mulAdd возвращает a*b + c для неотрицательных чисел, false - при переполнении
+func mulAdd(a, b, c int64) (int64, bool) {
+	if b != 0 && a > (math.MaxInt64-c)/b {
+		return 0, false
+	}
+	return a*b + c, true
+}
+
+func check(expires time.Time, now time.Time) (int64, error) {
+	if maximum != nil && expires.After(maximum.AddTo(now)) {
+		return 0, ErrTooLong
+	}
+	return expires.Unix(), nil
+}
@@ -0,0 +1,97 @@
+package expiry
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		value string
+		want  Duration
+	}{
+		{"day", Duration{Days: 1}},
+		{"WEEK", Duration{Days: 7}},
+		{"P90D", Duration{Days: 90}},
+		{"p1y2m", Duration{Years: 1, Months: 2}},
+		{"P2W3D", Duration{Days: 17}},
+		{"PT12H", Duration{Clock: 12 * time.Hour}},
+		{"PT1H30M", Duration{Clock: 90 * time.Minute}},
+		{"PT1.5S", Duration{Clock: 1500 * time.Millisecond}},
+		{"PT2,5S", Duration{Clock: 2500 * time.Millisecond}},
+		{"P1DT1M", Duration{Days: 1, Clock: time.Minute}},
+		{"PT2562047H", Duration{Clock: 2562047 * time.Hour}},
+	}
+	for _, tt := range tests {
+		got, err := ParseDuration(tt.value)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseDuration(%q) = %+v, %v, want %+v", tt.value, got, err, tt.want)
+		}
+	}
+}
+
+func TestParseDurationRejects(t *testing.T) {
+	for _, value := range []string{
+		"", "P", "PT", "P1DT", "1D", "P-1D", "P1.5D", "PT1H1H", "fortnight", "P0D", "PT0S", "PT0,5S",
+		//Число не помещается в int64
+		"P99999999999999999999D",
+		"PT99999999999999999999H",
+		//Переполнение при умножении на 7, на длину часа или минуты
+		"P1317624576693539401W",
+		"P1317624576693539400W7D",
+		"PT2562048H",
+		"PT153722867281M",
+		"PT2562047H60M",
+		"PT9223372037S",
+		"PT1e9S",
+		//Календарные части, на которых AddDate переполнился бы молча
+		"P1000000000000000000W",
+		"P292277026596Y",
+		"P9223372036854775807Y",
+		"P3000000000000000M",
+	} {
+		if got, err := ParseDuration(value); !errors.Is(err, ErrFormat) {
+			t.Errorf("ParseDuration(%q) = %+v, %v, want ErrFormat", value, got, err)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	now := time.Date(2024, time.January, 31, 12, 0, 0, 0, time.UTC)
+	t.Cleanup(func() { SetMaximum("") })
+
+	tests := []struct {
+		value string
+		want  time.Time
+		err   error
+	}{
+		{"P1M", time.Date(2024, time.March, 2, 12, 0, 0, 0, time.UTC), nil},
+		{"hour", now.Add(time.Hour), nil},
+		{"2024-02-01T00:00:00Z", time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC), nil},
+		{"2024-01-01T00:00:00Z", time.Time{}, ErrPast},
+		{"P99999999999999999999D", time.Time{}, ErrFormat},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.value, now)
+		if !errors.Is(err, tt.err) || (tt.err == nil && got != tt.want.Unix()) {
+			t.Errorf("Parse(%q) = %d, %v, want %d, %v", tt.value, got, err, tt.want.Unix(), tt.err)
+		}
+	}
+	if got, err := Parse("forever", now); got != Never || err != nil {
+		t.Errorf("Parse(forever) = %d, %v", got, err)
+	}
+
+	if err := SetMaximum("P1W"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Parse("P8D", now); !errors.Is(err, ErrTooLong) {
+		t.Errorf("Parse(P8D) with a one week maximum: err = %v, want ErrTooLong", err)
+	}
+	if _, err := Parse("never", now); !errors.Is(err, ErrTooLong) {
+		t.Errorf("Parse(never) with a maximum: err = %v, want ErrTooLong", err)
+	}
+	if got, err := Parse("", now); err != nil || got != now.AddDate(0, 0, 7).Unix() {
+		t.Errorf("Parse of an empty value = %d, %v, want the maximum", got, err)
+	}
+}
@@ -0,0 +1,51 @@
+package importer
+
+import (
+	"io/fs"
+
+	"pasteGo/backend/detect"
+)
+
+// Каждый текстовый файл каталога становится отдельной вставкой,
+// заголовок - относительный путь, время создания - время изменения файла
+func parseDirectory(fsys fs.FS, opts Options) ([]Entry, error) {
+	entries := make([]Entry, 0)
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if isHidden(name) {
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		data, err := readFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		if !isText(data) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		language := detect.Detect(name, string(data))
+		entries = append(entries, Entry{
+			Title:              name,
+			Language:           language.Language,
+			LanguageConfidence: language.Confidence,
+			Text:               string(data),
+			Created:            info.ModTime(),
+			Public:             opts.Public,
+		})
+		return nil
+	})
+	return entries, err
+}
@@ -0,0 +1,128 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"pasteGo/backend/detect"
+)
+
+// Формат ответа GitHub API (GET /users/:user/gists) и экспорта гистов.
+// Содержимое файла берётся из поля content, а если его нет -
+// из каталога <id гиста>/<имя файла> рядом с JSON.
+type gist struct {
+	Id          string              `json:"id"`
+	Description string              `json:"description"`
+	Public      bool                `json:"public"`
+	CreatedAt   time.Time           `json:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+	Files       map[string]gistFile `json:"files"`
+}
+
+type gistFile struct {
+	Filename string `json:"filename"`
+	Language string `json:"language"`
+	Content  string `json:"content"`
+}
+
+func parseGist(fsys fs.FS, opts Options) ([]Entry, error) {
+	entries := make([]Entry, 0)
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || isHidden(name) || !strings.EqualFold(path.Ext(name), ".json") {
+			return nil
+		}
+
+		data, err := readFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		gists, err := decodeGists(data)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		for i := range gists {
+			parsed, err := gistEntries(fsys, path.Dir(name), &gists[i])
+			if err != nil {
+				return err
+			}
+			entries = append(entries, parsed...)
+		}
+		return nil
+	})
+	return entries, err
+}
+
+// decodeGists принимает как один гист, так и массив гистов
+func decodeGists(data []byte) ([]gist, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		gists := make([]gist, 0)
+		err := json.Unmarshal(data, &gists)
+		return gists, err
+	}
+	single := gist{}
+	if err := json.Unmarshal(data, &single); err != nil {
+		return nil, err
+	}
+	return []gist{single}, nil
+}
+
+// Гист из нескольких файлов импортируется как одна вставка-набор файлов
+func gistEntries(fsys fs.FS, dir string, g *gist) ([]Entry, error) {
+	names := make([]string, 0, len(g.Files))
+	for name := range g.Files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	files := make([]File, 0, len(names))
+	for _, key := range names {
+		file := g.Files[key]
+		if file.Filename == "" {
+			file.Filename = key
+		}
+
+		text := file.Content
+		if text == "" && g.Id != "" {
+			data, err := readFile(fsys, path.Join(dir, g.Id, file.Filename))
+			if err == nil && isText(data) {
+				text = string(data)
+			}
+		}
+
+		language := detect.Normalize(file.Language)
+		if language == "" {
+			language = detect.Detect(file.Filename, text).Language
+		}
+		files = append(files, File{Name: file.Filename, Language: language, Text: text})
+	}
+	if len(files) == 0 {
+		return nil, nil
+	}
+
+	entry := Entry{
+		Title:   strings.TrimSpace(g.Description),
+		Created: g.CreatedAt,
+		Updated: g.UpdatedAt,
+		Public:  g.Public,
+	}
+	if len(files) == 1 {
+		if entry.Title == "" {
+			entry.Title = files[0].Name
+		}
+		entry.Language = files[0].Language
+		entry.Text = files[0].Text
+	} else {
+		entry.Files = files
+	}
+	return []Entry{entry}, nil
+}
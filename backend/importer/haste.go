@@ -0,0 +1,148 @@
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"pasteGo/backend/detect"
+)
+
+// Выгрузка haste-server поддерживается в трёх видах:
+//   - *.json: объект {"ключ": "текст"} или {"ключ": {"data": "текст"}};
+//   - *.jsonl, *.ndjson: строки вида {"key": "ключ", "data": "текст"};
+//   - любые другие файлы - файловое хранилище, где имя файла является ключом.
+type hasteRecord struct {
+	Key     string `json:"key"`
+	Data    string `json:"data"`
+	Value   string `json:"value"`
+	Created int64  `json:"created"`
+}
+
+func (record *hasteRecord) text() string {
+	if record.Data != "" {
+		return record.Data
+	}
+	return record.Value
+}
+
+func parseHaste(fsys fs.FS, opts Options) ([]Entry, error) {
+	entries := make([]Entry, 0)
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || isHidden(name) {
+			return nil
+		}
+
+		data, err := readFile(fsys, name)
+		if err != nil {
+			return err
+		}
+
+		var parsed []Entry
+		switch strings.ToLower(path.Ext(name)) {
+		case ".json":
+			parsed, err = parseHasteJSON(data, opts)
+		case ".jsonl", ".ndjson":
+			parsed, err = parseHasteLines(data, opts)
+		default:
+			if !isText(data) {
+				return nil
+			}
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			parsed = []Entry{hasteEntry(path.Base(name), string(data), info.ModTime(), opts)}
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		entries = append(entries, parsed...)
+		return nil
+	})
+	return entries, err
+}
+
+func parseHasteJSON(data []byte, opts Options) ([]Entry, error) {
+	raw := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(raw))
+	for key := range raw {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	entries := make([]Entry, 0, len(keys))
+	for _, key := range keys {
+		value := bytes.TrimSpace(raw[key])
+		if len(value) > 0 && value[0] == '"' {
+			var text string
+			if err := json.Unmarshal(value, &text); err != nil {
+				return nil, err
+			}
+			entries = append(entries, hasteEntry(key, text, time.Time{}, opts))
+			continue
+		}
+
+		record := hasteRecord{}
+		if err := json.Unmarshal(value, &record); err != nil {
+			return nil, err
+		}
+		entries = append(entries, hasteEntry(key, record.text(), unixTime(record.Created), opts))
+	}
+	return entries, nil
+}
+
+func parseHasteLines(data []byte, opts Options) ([]Entry, error) {
+	entries := make([]Entry, 0)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 64*1024), MaxEntrySize)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		record := hasteRecord{}
+		if err := json.Unmarshal(line, &record); err != nil {
+			return nil, err
+		}
+		entries = append(entries, hasteEntry(record.Key, record.text(), unixTime(record.Created), opts))
+	}
+	return entries, scanner.Err()
+}
+
+func hasteEntry(key string, text string, created time.Time, opts Options) Entry {
+	language := detect.Detect(key, text)
+	return Entry{
+		Title:              key,
+		Language:           language.Language,
+		LanguageConfidence: language.Confidence,
+		Text:               text,
+		Created:            created,
+		Public:             opts.Public,
+	}
+}
+
+// unixTime понимает отметки как в секундах, так и в миллисекундах
+func unixTime(value int64) time.Time {
+	switch {
+	case value <= 0:
+		return time.Time{}
+	case value > 1e12:
+		return time.UnixMilli(value)
+	default:
+		return time.Unix(value, 0)
+	}
+}
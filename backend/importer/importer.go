@@ -0,0 +1,322 @@
+package importer
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"pasteGo/backend/db"
+	"pasteGo/backend/db/typesDB"
+	"pasteGo/backend/expiry"
+
+	"github.com/google/uuid"
+)
+
+const (
+	MaxArchiveSize    = 64 << 20 //Суммарный размер распакованного архива
+	MaxEntrySize      = 10 << 20 //Размер одного импортируемого файла
+	MaxArchiveEntries = 10000    //Число записей архива, включая каталоги и пропускаемые файлы
+)
+
+var (
+	ErrUnknownFormat = errors.New("unknown import format")
+	ErrTooLarge      = errors.New("import data is too large")
+)
+
+// Entry - одна вставка, извлечённая из чужого формата
+type Entry struct {
+	Title              string
+	Language           string
+	LanguageConfidence float64
+	Text               string
+	Files              []File
+	Created            time.Time
+	Updated            time.Time
+	Public             bool
+}
+
+type File struct {
+	Name     string
+	Language string
+	Text     string
+}
+
+// Options - параметры для форматов, которые сами не хранят видимость
+type Options struct {
+	Public bool
+}
+
+type Format func(fsys fs.FS, opts Options) ([]Entry, error)
+
+var formats = map[string]Format{
+	"gist":      parseGist,
+	"haste":     parseHaste,
+	"directory": parseDirectory,
+}
+
+func Formats() []string {
+	names := make([]string, 0, len(formats))
+	for name := range formats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func Parse(format string, fsys fs.FS, opts Options) ([]Entry, error) {
+	parse, ok := formats[format]
+	if !ok {
+		return nil, ErrUnknownFormat
+	}
+	return parse(fsys, opts)
+}
+
+// OpenPath открывает каталог, архив (.zip, .tar, .tar.gz, .tgz) или одиночный файл
+func OpenPath(name string) (fs.FS, error) {
+	info, err := os.Stat(name)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return os.DirFS(name), nil
+	}
+	if info.Size() > MaxArchiveSize {
+		return nil, ErrTooLarge
+	}
+
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	return OpenArchive(filepath.Base(name), data, info.ModTime())
+}
+
+// OpenArchive превращает загруженные данные в fs.FS по расширению имени файла
+func OpenArchive(name string, data []byte, modTime time.Time) (fs.FS, error) {
+	lower := strings.ToLower(name)
+	switch {
+	case strings.HasSuffix(lower, ".zip"):
+		return readZip(data)
+	case strings.HasSuffix(lower, ".tar.gz"), strings.HasSuffix(lower, ".tgz"):
+		gz, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+		return readTar(gz)
+	case strings.HasSuffix(lower, ".tar"):
+		return readTar(bytes.NewReader(data))
+	}
+
+	base := path.Base(filepath.ToSlash(name))
+	if !fs.ValidPath(base) || base == "." {
+		base = "paste.txt"
+	}
+	return memFS{base: &memFile{data: data, modTime: modTime}}, nil
+}
+
+func readTar(r io.Reader) (fs.FS, error) {
+	fsys := memFS{}
+	tr := tar.NewReader(r)
+	var total int64
+	for count := 1; ; count++ {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if count > MaxArchiveEntries {
+			return nil, ErrTooLarge
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		name := strings.TrimPrefix(path.Clean(header.Name), "/")
+		if !fs.ValidPath(name) {
+			continue
+		}
+
+		total += header.Size
+		if header.Size > MaxEntrySize || total > MaxArchiveSize {
+			return nil, ErrTooLarge
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		fsys[name] = &memFile{data: data, modTime: header.ModTime}
+	}
+	return fsys, nil
+}
+
+// readZip распаковывает zip с теми же ограничениями, что и readTar. Размер из заголовка
+// может быть занижен, поэтому проверяется и то, сколько данных прочитано на самом деле
+func readZip(data []byte) (fs.FS, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	if len(zr.File) > MaxArchiveEntries {
+		return nil, ErrTooLarge
+	}
+
+	fsys := memFS{}
+	var total int64
+	for _, file := range zr.File {
+		if !file.Mode().IsRegular() {
+			continue
+		}
+		name := strings.TrimPrefix(path.Clean(file.Name), "/")
+		if !fs.ValidPath(name) {
+			continue
+		}
+		if file.UncompressedSize64 > MaxEntrySize || total+int64(file.UncompressedSize64) > MaxArchiveSize {
+			return nil, ErrTooLarge
+		}
+
+		rc, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+		data, err := io.ReadAll(io.LimitReader(rc, MaxEntrySize+1))
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+		total += int64(len(data))
+		if len(data) > MaxEntrySize || total > MaxArchiveSize {
+			return nil, ErrTooLarge
+		}
+		fsys[name] = &memFile{data: data, modTime: file.Modified}
+	}
+	return fsys, nil
+}
+
+func readFile(fsys fs.FS, name string) ([]byte, error) {
+	file, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, MaxEntrySize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > MaxEntrySize {
+		return nil, fmt.Errorf("%s: %w", name, ErrTooLarge)
+	}
+	return data, nil
+}
+
+// isText отсекает бинарные файлы, которые нельзя хранить как текст вставки
+func isText(data []byte) bool {
+	return utf8.Valid(data) && !bytes.ContainsRune(data, 0)
+}
+
+func isHidden(name string) bool {
+	for _, part := range strings.Split(name, "/") {
+		if strings.HasPrefix(part, ".") && part != "." {
+			return true
+		}
+	}
+	return false
+}
+
+// HasContent - сохранит ли Save эту вставку: пустые пропускаются
+func (entry *Entry) HasContent() bool {
+	return strings.TrimSpace(entry.Text) != "" || len(entry.Files) > 0
+}
+
+// Size - объём текста вставки вместе с файлами, как у вставок через API
+func (entry *Entry) Size() int64 {
+	size := int64(len(entry.Text))
+	for _, file := range entry.Files {
+		size += int64(len(file.Text))
+	}
+	return size
+}
+
+// Size возвращает число вставок и объём текста, которые сохранит Save
+func Size(entries []Entry) (int64, int64) {
+	var pastes, size int64
+	for i := range entries {
+		if !entries[i].HasContent() {
+			continue
+		}
+		pastes++
+		size += entries[i].Size()
+	}
+	return pastes, size
+}
+
+// Save сохраняет вставки от имени пользователя одной транзакцией: при ошибке не сохраняется ничего
+func Save(DBInstance *db.DBInstance, userId string, entries []Entry) ([]typesDB.PasteRecord, error) {
+	records := make([]typesDB.PasteRecord, 0, len(entries))
+	files := make([][]typesDB.PasteFileRecord, 0, len(entries))
+	timeNow := time.Now()
+	for i := range entries {
+		if !entries[i].HasContent() {
+			continue
+		}
+
+		created := entries[i].Created
+		if created.IsZero() || created.After(timeNow) {
+			created = timeNow
+		}
+		var updated int64 = -1
+		if !entries[i].Updated.IsZero() && entries[i].Updated.After(created) {
+			updated = entries[i].Updated.Unix()
+		}
+
+		record := typesDB.PasteRecord{
+			Id:                 uuid.New().String(),
+			UserId:             userId,
+			Title:              entries[i].Title,
+			Language:           entries[i].Language,
+			LanguageConfidence: entries[i].LanguageConfidence,
+			ContentType:        typesDB.ContentTypeText,
+			Text:               entries[i].Text,
+			Created:            created.Unix(),
+			Updated:            updated,
+			Lifetime:           expiry.Default(timeNow),
+			Password:           "",
+			Public:             typesDB.BoolToInt(entries[i].Public),
+		}
+		if len(entries[i].Files) == 0 && entries[i].Language == "markdown" {
+			record.ContentType = typesDB.ContentTypeMarkdown
+		}
+
+		pasteFiles := make([]typesDB.PasteFileRecord, 0, len(entries[i].Files))
+		for j, file := range entries[i].Files {
+			pasteFiles = append(pasteFiles, typesDB.PasteFileRecord{
+				PasteId:  record.Id,
+				Position: j,
+				Name:     file.Name,
+				Language: file.Language,
+				Text:     file.Text,
+			})
+		}
+		records = append(records, record)
+		files = append(files, pasteFiles)
+	}
+
+	if err := DBInstance.AddPasteRecords(records, files); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
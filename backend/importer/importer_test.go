@@ -0,0 +1,320 @@
+package importer
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"slices"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"pasteGo/backend/db"
+	"pasteGo/backend/db/typesDB"
+)
+
+func titles(entries []Entry) []string {
+	result := make([]string, 0, len(entries))
+	for i := range entries {
+		result = append(result, entries[i].Title)
+	}
+	return result
+}
+
+// fixtureFiles читает все файлы каталога testdata/<dir>: путь -> содержимое
+func fixtureFiles(t *testing.T, dir string) map[string][]byte {
+	t.Helper()
+	fsys := os.DirFS("testdata/" + dir)
+	files := map[string][]byte{}
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		files[name], err = fs.ReadFile(fsys, name)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return files
+}
+
+func zipArchive(t *testing.T, files map[string][]byte) []byte {
+	t.Helper()
+	buf := bytes.Buffer{}
+	zw := zip.NewWriter(&buf)
+	for name, data := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write(data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func tarArchive(t *testing.T, files map[string][]byte, compress bool) []byte {
+	t.Helper()
+	buf := bytes.Buffer{}
+	var gz *gzip.Writer
+	tw := tar.NewWriter(&buf)
+	if compress {
+		gz = gzip.NewWriter(&buf)
+		tw = tar.NewWriter(gz)
+	}
+	for name, data := range files {
+		header := &tar.Header{Name: name, Mode: 0644, Size: int64(len(data)), ModTime: time.Unix(1700000000, 0)}
+		if err := tw.WriteHeader(header); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write(data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if gz != nil {
+		if err := gz.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return buf.Bytes()
+}
+
+func TestParseGist(t *testing.T) {
+	entries, err := Parse("gist", os.DirFS("testdata/gist"), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := titles(entries), []string{"Build helpers", "hello.py"}; !slices.Equal(got, want) {
+		t.Fatalf("titles = %q, want %q", got, want)
+	}
+
+	multi := entries[0]
+	if !multi.Public || multi.Text != "" || len(multi.Files) != 2 {
+		t.Fatalf("multi-file gist = %+v", multi)
+	}
+	if multi.Files[0].Name != "Makefile" || multi.Files[1].Name != "main.go" {
+		t.Errorf("files are not sorted by name: %q, %q", multi.Files[0].Name, multi.Files[1].Name)
+	}
+	if multi.Files[1].Text != "package main\n\nfunc main() {}\n" {
+		t.Errorf("content without the content field should come from aa11/main.go, got %q", multi.Files[1].Text)
+	}
+	if !multi.Created.Equal(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("created = %v", multi.Created)
+	}
+
+	single := entries[1]
+	if single.Public || len(single.Files) != 0 || single.Text != "print('hello')\n" || single.Language == "" {
+		t.Errorf("single-file gist = %+v", single)
+	}
+}
+
+func TestParseHaste(t *testing.T) {
+	entries, err := Parse("haste", os.DirFS("testdata/haste"), Options{Public: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		title   string
+		text    string
+		created time.Time
+	}{
+		{"okafepu", "SELECT 1;", time.Time{}},
+		{"ruvoxa", "echo hi", time.UnixMilli(1700000000000)},
+		{"iyeluq", "first line", time.Unix(1700000000, 0)},
+		{"zomuki", "second line", time.Time{}},
+		{"abazuro", "plain text from the file store\n", time.Time{}},
+	}
+	if len(entries) != len(want) {
+		t.Fatalf("titles = %q", titles(entries))
+	}
+	for i, w := range want {
+		entry := entries[i]
+		if entry.Title != w.title || entry.Text != w.text || !entry.Public {
+			t.Errorf("entry %d = %+v, want %s: %q", i, entry, w.title, w.text)
+		}
+		// Время файлового хранилища - время изменения файла, его не сравниваем
+		if w.title != "abazuro" && !entry.Created.Equal(w.created) {
+			t.Errorf("%s created = %v, want %v", w.title, entry.Created, w.created)
+		}
+	}
+}
+
+func TestParseDirectory(t *testing.T) {
+	entries, err := Parse("directory", os.DirFS("testdata/directory"), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// image.bin - бинарный файл, .cache - скрытый каталог
+	if got, want := titles(entries), []string{"notes.md", "src/main.py"}; !slices.Equal(got, want) {
+		t.Fatalf("titles = %q, want %q", got, want)
+	}
+	if entries[1].Text != "def main():\n    return 0\n" || entries[1].Public {
+		t.Errorf("src/main.py = %+v", entries[1])
+	}
+}
+
+func TestParseUnknownFormat(t *testing.T) {
+	if _, err := Parse("pastebin", os.DirFS("testdata/directory"), Options{}); !errors.Is(err, ErrUnknownFormat) {
+		t.Fatalf("err = %v, want ErrUnknownFormat", err)
+	}
+}
+
+func TestOpenArchive(t *testing.T) {
+	files := fixtureFiles(t, "directory")
+	tests := []struct {
+		name string
+		data []byte
+		want []string
+	}{
+		{"export.zip", zipArchive(t, files), []string{"notes.md", "src/main.py"}},
+		{"export.tar", tarArchive(t, files, false), []string{"notes.md", "src/main.py"}},
+		{"export.tar.gz", tarArchive(t, files, true), []string{"notes.md", "src/main.py"}},
+		{"EXPORT.TGZ", tarArchive(t, files, true), []string{"notes.md", "src/main.py"}},
+		{"notes.md", files["notes.md"], []string{"notes.md"}},
+		{"../..", files["notes.md"], []string{"paste.txt"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsys, err := OpenArchive(tt.name, tt.data, time.Now())
+			if err != nil {
+				t.Fatal(err)
+			}
+			entries, err := Parse("directory", fsys, Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := titles(entries); !slices.Equal(got, tt.want) {
+				t.Fatalf("titles = %q, want %q", got, tt.want)
+			}
+			if entries[0].Text != string(files["notes.md"]) {
+				t.Errorf("text = %q", entries[0].Text)
+			}
+		})
+	}
+}
+
+func TestOpenArchiveTooLarge(t *testing.T) {
+	// Заголовок обещает файл больше MaxEntrySize: архив отклоняется до чтения содержимого
+	buf := bytes.Buffer{}
+	tw := tar.NewWriter(&buf)
+	if err := tw.WriteHeader(&tar.Header{Name: "big.txt", Mode: 0644, Size: MaxEntrySize + 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := OpenArchive("big.tar", buf.Bytes(), time.Now()); !errors.Is(err, ErrTooLarge) {
+		t.Fatalf("err = %v, want ErrTooLarge", err)
+	}
+}
+
+func TestMemFS(t *testing.T) {
+	fsys := memFS{
+		"notes.md":          {data: []byte("# Notes\n"), modTime: time.Unix(1700000000, 0)},
+		"src/main.py":       {data: []byte("pass\n")},
+		"src/lib/util.py":   {data: []byte("")},
+		".cache/config.ini": {data: []byte("[core]\n")},
+	}
+	if err := fstest.TestFS(fsys, "notes.md", "src/main.py", "src/lib/util.py", ".cache/config.ini"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fsys.Open("../notes.md"); !errors.Is(err, fs.ErrInvalid) {
+		t.Errorf("open ../notes.md: err = %v", err)
+	}
+}
+
+func TestOpenArchiveZipLimits(t *testing.T) {
+	tooMany := map[string][]byte{}
+	for i := 0; i <= MaxArchiveEntries; i++ {
+		tooMany[fmt.Sprintf("empty/%05d.txt", i)] = nil
+	}
+	full := make([]byte, MaxEntrySize)
+	tooBig := map[string][]byte{}
+	for i := 0; i <= MaxArchiveSize/MaxEntrySize; i++ {
+		tooBig[fmt.Sprintf("part%d.txt", i)] = full
+	}
+
+	tests := []struct {
+		name  string
+		files map[string][]byte
+	}{
+		{"entry over MaxEntrySize", map[string][]byte{"bomb.txt": make([]byte, MaxEntrySize+1)}},
+		{"total over MaxArchiveSize", tooBig},
+		{"more than MaxArchiveEntries", tooMany},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := OpenArchive("bomb.zip", zipArchive(t, tt.files), time.Now()); !errors.Is(err, ErrTooLarge) {
+				t.Fatalf("err = %v, want ErrTooLarge", err)
+			}
+		})
+	}
+}
+
+// openTestDB открывает пустую базу во временном каталоге: путь к базе задан относительно рабочего каталога
+func openTestDB(t *testing.T) *db.DBInstance {
+	t.Helper()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		db.CloseDB()
+		os.Chdir(wd)
+	})
+
+	DBInstance, err := db.GetDBInstance()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := DBInstance.Init(); err != nil {
+		t.Fatal(err)
+	}
+	return DBInstance
+}
+
+func TestSaveIsAtomic(t *testing.T) {
+	DBInstance := openTestDB(t)
+	user := typesDB.UserRecord{Id: "u1", Username: "alice", Password: "x"}
+	if _, err := DBInstance.AddUserRecord(&user); err != nil {
+		t.Fatal(err)
+	}
+
+	good := Entry{Title: "first", Text: "hello"}
+	// Два файла с одним именем нарушают первичный ключ paste_files уже после сохранения первой вставки
+	broken := Entry{Title: "second", Files: []File{{Name: "dup.txt", Text: "a"}, {Name: "dup.txt", Text: "b"}}}
+	if _, err := Save(DBInstance, user.Id, []Entry{good, broken}); err == nil {
+		t.Fatal("Save accepted duplicate file names")
+	}
+	pastes, err := DBInstance.GetPasteRecordsByUserId(user.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*pastes) != 0 {
+		t.Fatalf("failed import left %d pastes", len(*pastes))
+	}
+
+	records, err := Save(DBInstance, user.Id, []Entry{good, {Title: "blank", Text: "  "}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || records[0].Version != 1 {
+		t.Fatalf("records = %+v", records)
+	}
+	if _, exists, err := DBInstance.GetPasteRecordById(records[0].Id); err != nil || !exists {
+		t.Fatalf("saved paste not found: %v", err)
+	}
+}
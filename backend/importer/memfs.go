@@ -0,0 +1,144 @@
+package importer
+
+import (
+	"bytes"
+	"io"
+	"io/fs"
+	"sort"
+	"strings"
+	"time"
+)
+
+// memFS - распакованный архив в памяти: путь файла -> содержимое.
+// Каталоги не хранятся, они следуют из путей файлов
+type memFS map[string]*memFile
+
+type memFile struct {
+	data    []byte
+	modTime time.Time
+}
+
+func (fsys memFS) Open(name string) (fs.File, error) {
+	info, err := fsys.Stat(name)
+	if err != nil {
+		err.(*fs.PathError).Op = "open"
+		return nil, err
+	}
+	if !info.IsDir() {
+		return &memReader{Reader: bytes.NewReader(fsys[name].data), info: info}, nil
+	}
+	entries, err := fsys.ReadDir(name)
+	if err != nil {
+		return nil, err
+	}
+	return &memDir{info: info, entries: entries}, nil
+}
+
+func (fsys memFS) Stat(name string) (fs.FileInfo, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "stat", Path: name, Err: fs.ErrInvalid}
+	}
+	if file, ok := fsys[name]; ok {
+		return &memInfo{name: pathBase(name), size: int64(len(file.data)), mode: 0644, modTime: file.modTime}, nil
+	}
+	if name == "." {
+		return &memInfo{name: ".", mode: fs.ModeDir | 0755}, nil
+	}
+	for key := range fsys {
+		if strings.HasPrefix(key, name+"/") {
+			return &memInfo{name: pathBase(name), mode: fs.ModeDir | 0755}, nil
+		}
+	}
+	return nil, &fs.PathError{Op: "stat", Path: name, Err: fs.ErrNotExist}
+}
+
+// ReadDir возвращает непосредственных потомков каталога, отсортированных по имени
+func (fsys memFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	info, err := fsys.Stat(name)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrInvalid}
+	}
+
+	prefix := name + "/"
+	if name == "." {
+		prefix = ""
+	}
+	children := map[string]fs.FileInfo{}
+	for key := range fsys {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		child, _, _ := strings.Cut(key[len(prefix):], "/")
+		if _, seen := children[child]; seen {
+			continue
+		}
+		if children[child], err = fsys.Stat(prefix + child); err != nil {
+			return nil, err
+		}
+	}
+
+	entries := make([]fs.DirEntry, 0, len(children))
+	for _, child := range children {
+		entries = append(entries, fs.FileInfoToDirEntry(child))
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+	return entries, nil
+}
+
+func pathBase(name string) string {
+	return name[strings.LastIndex(name, "/")+1:]
+}
+
+type memInfo struct {
+	name    string
+	size    int64
+	mode    fs.FileMode
+	modTime time.Time
+}
+
+func (info *memInfo) Name() string       { return info.name }
+func (info *memInfo) Size() int64        { return info.size }
+func (info *memInfo) Mode() fs.FileMode  { return info.mode }
+func (info *memInfo) ModTime() time.Time { return info.modTime }
+func (info *memInfo) IsDir() bool        { return info.mode.IsDir() }
+func (info *memInfo) Sys() any           { return nil }
+
+type memReader struct {
+	*bytes.Reader
+	info fs.FileInfo
+}
+
+func (file *memReader) Stat() (fs.FileInfo, error) { return file.info, nil }
+func (file *memReader) Close() error               { return nil }
+
+type memDir struct {
+	info    fs.FileInfo
+	entries []fs.DirEntry
+	offset  int
+}
+
+func (dir *memDir) Stat() (fs.FileInfo, error) { return dir.info, nil }
+func (dir *memDir) Close() error               { return nil }
+
+func (dir *memDir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: dir.info.Name(), Err: fs.ErrInvalid}
+}
+
+func (dir *memDir) ReadDir(count int) ([]fs.DirEntry, error) {
+	rest := dir.entries[dir.offset:]
+	if count <= 0 {
+		dir.offset = len(dir.entries)
+		return rest, nil
+	}
+	if len(rest) == 0 {
+		return nil, io.EOF
+	}
+	if count > len(rest) {
+		count = len(rest)
+	}
+	dir.offset += count
+	return rest[:count], nil
+}
@@ -0,0 +1,58 @@
+package mail
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// LogMailer печатает письма в журнал сервера. Только для разработки:
+// токены сброса пароля окажутся в логах
+type LogMailer struct{}
+
+func (LogMailer) Send(ctx context.Context, msg Message) error {
+	log.Printf("mail to %s: %s\n%s", msg.To, msg.Subject, msg.Body)
+	return nil
+}
+
+// FileMailer дописывает письма в файл по одному JSON на строку, чтобы тесты
+// и локальные стенды могли их прочитать
+type FileMailer struct {
+	path  string
+	mutex sync.Mutex
+}
+
+type fileMessage struct {
+	Time int64 `json:"time"`
+	Message
+}
+
+func NewFileMailer(path string) *FileMailer {
+	return &FileMailer{path: path}
+}
+
+func (mailer *FileMailer) Send(ctx context.Context, msg Message) error {
+	line, err := json.Marshal(fileMessage{Time: time.Now().Unix(), Message: msg})
+	if err != nil {
+		return err
+	}
+
+	mailer.mutex.Lock()
+	defer mailer.mutex.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(mailer.path), 0750); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(mailer.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.Write(append(line, '\n'))
+	return err
+}
@@ -0,0 +1,89 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"pasteGo/backend/config"
+	"strings"
+	"sync"
+	"time"
+)
+
+var ErrDisabled = errors.New("mail is disabled")
+
+// Message - письмо в виде обычного текста
+type Message struct {
+	To      string `json:"to"`
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+// Mailer отправляет письма пользователям
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+var (
+	instance Mailer
+	mutex    sync.Mutex
+)
+
+// GetMailer создаёт отправителя, выбранного в настройках (MAIL_TRANSPORT).
+// При MAIL_TRANSPORT=none возвращает ErrDisabled
+func GetMailer() (Mailer, error) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if instance != nil {
+		return instance, nil
+	}
+
+	var err error
+	switch config.Mail.Transport {
+	case "none":
+		err = ErrDisabled
+	case "log":
+		instance = LogMailer{}
+	case "file":
+		instance = NewFileMailer(config.Mail.File)
+	case "smtp":
+		instance = NewSMTPMailer(config.Mail)
+	default:
+		err = fmt.Errorf("unknown mail transport %q", config.Mail.Transport)
+	}
+	if err != nil {
+		instance = nil
+		return nil, err
+	}
+	return instance, nil
+}
+
+// compose собирает письмо RFC 5322. Перевод строки в адресе или теме
+// позволил бы дописать свои заголовки, поэтому такие письма не отправляются
+func compose(from string, msg Message) ([]byte, error) {
+	if strings.ContainsAny(from+msg.To+msg.Subject, "\r\n") {
+		return nil, errors.New("mail header contains a line break")
+	}
+
+	buffer := bytes.Buffer{}
+	fmt.Fprintf(&buffer, "From: %s\r\n", from)
+	fmt.Fprintf(&buffer, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&buffer, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&buffer, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buffer.WriteString("MIME-Version: 1.0\r\n")
+	buffer.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buffer.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+
+	writer := quotedprintable.NewWriter(&buffer)
+	if _, err := writer.Write([]byte(strings.ReplaceAll(msg.Body, "\n", "\r\n"))); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,97 @@
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	netmail "net/mail"
+	"net/smtp"
+	"pasteGo/backend/config"
+	"strconv"
+)
+
+// SMTPMailer отправляет письма через SMTP сервер
+type SMTPMailer struct {
+	cfg config.MailConfig
+}
+
+func NewSMTPMailer(cfg config.MailConfig) *SMTPMailer {
+	return &SMTPMailer{cfg: cfg}
+}
+
+func (mailer *SMTPMailer) Send(ctx context.Context, msg Message) error {
+	data, err := compose(mailer.cfg.From, msg)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, mailer.cfg.Timeout)
+	defer cancel()
+
+	client, err := mailer.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if !mailer.cfg.SMTPTLS {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(&tls.Config{ServerName: mailer.cfg.SMTPHost}); err != nil {
+				return err
+			}
+		}
+	}
+	//PlainAuth сам откажется передавать пароль без TLS, кроме localhost
+	if mailer.cfg.SMTPUsername != "" {
+		auth := smtp.PlainAuth("", mailer.cfg.SMTPUsername, mailer.cfg.SMTPPassword, mailer.cfg.SMTPHost)
+		if err := client.Auth(auth); err != nil {
+			return err
+		}
+	}
+
+	//В конверте нужен голый адрес, MAIL_FROM может содержать имя: "pasteGo <pastego@example.com>"
+	from, err := netmail.ParseAddress(mailer.cfg.From)
+	if err != nil {
+		return err
+	}
+	if err := client.Mail(from.Address); err != nil {
+		return err
+	}
+	if err := client.Rcpt(msg.To); err != nil {
+		return err
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(data); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+func (mailer *SMTPMailer) dial(ctx context.Context) (*smtp.Client, error) {
+	addr := net.JoinHostPort(mailer.cfg.SMTPHost, strconv.FormatInt(mailer.cfg.SMTPPort, 10))
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	if mailer.cfg.SMTPTLS {
+		conn = tls.Client(conn, &tls.Config{ServerName: mailer.cfg.SMTPHost})
+	}
+	//Весь разговор с сервером ограничен сроком контекста
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, mailer.cfg.SMTPHost)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return client, nil
+}
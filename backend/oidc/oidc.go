@@ -0,0 +1,128 @@
+// Package oidc - вход через внешний OpenID Connect провайдер: authorization code с PKCE,
+// проверка ID токена по ключам провайдера и разбор claims по настройкам OIDC_*
+package oidc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pasteGo/backend/config"
+	"slices"
+	"sync"
+
+	gooidc "github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+)
+
+var ErrDisabled = errors.New("oidc is not configured")
+
+// Identity - пользователь провайдера по claims ID токена
+type Identity struct {
+	Provider string
+	Subject  string
+	Username string
+	Groups   []string
+}
+
+// InGroup сообщает, состоит ли пользователь в группе
+func (identity Identity) InGroup(group string) bool {
+	return slices.Contains(identity.Groups, group)
+}
+
+type Client struct {
+	oauth    oauth2.Config
+	verifier *gooidc.IDTokenVerifier
+}
+
+var (
+	client *Client
+	mu     sync.Mutex
+)
+
+// Enabled сообщает, настроен ли вход через OIDC
+func Enabled() bool {
+	return config.OIDC.Issuer != ""
+}
+
+// GetClient возвращает клиента провайдера. Discovery выполняется при первом вызове,
+// после ошибки - повторяется при следующем
+func GetClient(ctx context.Context) (*Client, error) {
+	if !Enabled() {
+		return nil, ErrDisabled
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if client != nil {
+		return client, nil
+	}
+
+	provider, err := gooidc.NewProvider(ctx, config.OIDC.Issuer)
+	if err != nil {
+		return nil, err
+	}
+	client = &Client{
+		oauth: oauth2.Config{
+			ClientID:     config.OIDC.ClientId,
+			ClientSecret: config.OIDC.ClientSecret,
+			Endpoint:     provider.Endpoint(),
+			RedirectURL:  config.OIDC.RedirectURL,
+			Scopes:       config.OIDC.Scopes,
+		},
+		verifier: provider.Verifier(&gooidc.Config{ClientID: config.OIDC.ClientId}),
+	}
+	return client, nil
+}
+
+// GenerateVerifier создаёт PKCE verifier для одного входа
+func GenerateVerifier() string {
+	return oauth2.GenerateVerifier()
+}
+
+// AuthCodeURL - адрес страницы входа провайдера
+func (client *Client) AuthCodeURL(state string, nonce string, verifier string) string {
+	return client.oauth.AuthCodeURL(state, gooidc.Nonce(nonce), oauth2.S256ChallengeOption(verifier))
+}
+
+// Exchange обменивает код авторизации на ID токен, проверяет его подпись, audience, срок и nonce
+func (client *Client) Exchange(ctx context.Context, code string, verifier string, nonce string) (Identity, error) {
+	token, err := client.oauth.Exchange(ctx, code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return Identity{}, err
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return Identity{}, errors.New("token response has no id_token")
+	}
+	idToken, err := client.verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return Identity{}, err
+	}
+	if idToken.Nonce != nonce {
+		return Identity{}, errors.New("id_token nonce does not match")
+	}
+
+	claims := map[string]any{}
+	if err := idToken.Claims(&claims); err != nil {
+		return Identity{}, err
+	}
+	identity := Identity{
+		Provider: idToken.Issuer,
+		Subject:  idToken.Subject,
+	}
+	identity.Username, _ = claims[config.OIDC.UsernameClaim].(string)
+	if identity.Username == "" {
+		return Identity{}, fmt.Errorf("id_token has no %q claim", config.OIDC.UsernameClaim)
+	}
+	//Группы приходят списком или, у части провайдеров, одной строкой
+	switch groups := claims[config.OIDC.GroupsClaim].(type) {
+	case []any:
+		for _, group := range groups {
+			if name, ok := group.(string); ok {
+				identity.Groups = append(identity.Groups, name)
+			}
+		}
+	case string:
+		identity.Groups = []string{groups}
+	}
+	return identity, nil
+}
@@ -0,0 +1,224 @@
+// Package ratelimit ограничивает попытки входа и ввода пароля вставки.
+// Ключ - строка вида ip:<адрес>, user:<имя>, paste:<id>: у каждого свой запас попыток
+// (token bucket) и счётчик неудач с растущей блокировкой
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"pasteGo/backend/config"
+	"sync"
+	"time"
+)
+
+// Limiter хранит состояние ключей. Встроенная реализация держит его в памяти процесса;
+// для нескольких экземпляров сервера нужна общая, например поверх Redis
+type Limiter interface {
+	// Allow расходует попытку. Если ключ заблокирован или исчерпал запас,
+	// возвращает false и время, через которое можно повторить
+	Allow(key string) (time.Duration, bool)
+	// Fail отмечает неудачную попытку и возвращает срок блокировки, 0 - ключ не заблокирован
+	Fail(key string) time.Duration
+	// Reset забывает неудачи и блокировку ключа. false - по ключу ничего не было
+	Reset(key string) bool
+	// Lockouts возвращает ключи с неудачными попытками
+	Lockouts() []Lockout
+}
+
+// Lockout - неудачные попытки ключа, LockedUntil нулевое, если блокировки нет
+type Lockout struct {
+	Key         string
+	Failures    int
+	LockedUntil time.Time
+}
+
+// Policy - параметры ограничения
+type Policy struct {
+	Burst     int           //Попыток подряд
+	PerMinute int           //Пополнение запаса
+	Threshold int           //Неудач до первой блокировки
+	Base      time.Duration //Первая блокировка, дальше удваивается
+	Max       time.Duration //Наибольшая блокировка и срок, через который неудачи забываются
+}
+
+func IPKey(ip string) string {
+	return "ip:" + ip
+}
+
+func UserKey(username string) string {
+	return "user:" + username
+}
+
+func PasteKey(id string) string {
+	return "paste:" + id
+}
+
+// ResetKey ограничивает письма сброса пароля на один аккаунт
+func ResetKey(userId string) string {
+	return "reset:" + userId
+}
+
+var (
+	instance Limiter
+	mutex    sync.Mutex
+)
+
+// GetLimiter создаёт ограничитель, выбранный в настройках (RATE_LIMIT_STORE)
+func GetLimiter() (Limiter, error) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if instance != nil {
+		return instance, nil
+	}
+
+	switch config.RateLimitStore {
+	case "memory":
+		instance = NewMemoryLimiter(Policy{
+			Burst:     int(config.RateLimitBurst),
+			PerMinute: int(config.RateLimitPerMinute),
+			Threshold: int(config.LockoutThreshold),
+			Base:      config.LockoutBase,
+			Max:       config.LockoutMax,
+		})
+	default:
+		return nil, fmt.Errorf("unknown rate limit store %q", config.RateLimitStore)
+	}
+	return instance, nil
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+type failures struct {
+	count       int
+	last        time.Time
+	lockedUntil time.Time
+}
+
+// MemoryLimiter - Limiter в памяти процесса
+type MemoryLimiter struct {
+	policy Policy
+	now    func() time.Time
+
+	mutex     sync.Mutex
+	buckets   map[string]*bucket
+	failures  map[string]*failures
+	lastPrune time.Time
+}
+
+func NewMemoryLimiter(policy Policy) *MemoryLimiter {
+	return &MemoryLimiter{
+		policy:   policy,
+		now:      time.Now,
+		buckets:  make(map[string]*bucket),
+		failures: make(map[string]*failures),
+	}
+}
+
+func (limiter *MemoryLimiter) Allow(key string) (time.Duration, bool) {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	now := limiter.now()
+	limiter.prune(now)
+
+	if entry := limiter.failures[key]; entry != nil && now.Before(entry.lockedUntil) {
+		return entry.lockedUntil.Sub(now), false
+	}
+
+	if limiter.policy.Burst <= 0 || limiter.policy.PerMinute <= 0 {
+		return 0, true
+	}
+	entry := limiter.buckets[key]
+	if entry == nil {
+		entry = &bucket{tokens: float64(limiter.policy.Burst), last: now}
+		limiter.buckets[key] = entry
+	}
+	entry.tokens = math.Min(float64(limiter.policy.Burst), entry.tokens+now.Sub(entry.last).Minutes()*float64(limiter.policy.PerMinute))
+	entry.last = now
+	if entry.tokens < 1 {
+		wait := (1 - entry.tokens) / float64(limiter.policy.PerMinute) * float64(time.Minute)
+		return time.Duration(wait), false
+	}
+	entry.tokens--
+	return 0, true
+}
+
+func (limiter *MemoryLimiter) Fail(key string) time.Duration {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	now := limiter.now()
+	entry := limiter.failures[key]
+	if entry == nil || limiter.forgotten(entry, now) {
+		entry = &failures{}
+		limiter.failures[key] = entry
+	}
+	entry.count++
+	entry.last = now
+
+	if limiter.policy.Threshold <= 0 || entry.count < limiter.policy.Threshold {
+		return 0
+	}
+	lock := limiter.policy.Base
+	for i := limiter.policy.Threshold; i < entry.count && lock < limiter.policy.Max; i++ {
+		lock *= 2
+	}
+	lock = min(lock, limiter.policy.Max)
+	entry.lockedUntil = now.Add(lock)
+	return lock
+}
+
+func (limiter *MemoryLimiter) Reset(key string) bool {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	_, exists := limiter.failures[key]
+	delete(limiter.failures, key)
+	return exists
+}
+
+func (limiter *MemoryLimiter) Lockouts() []Lockout {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	now := limiter.now()
+	lockouts := make([]Lockout, 0, len(limiter.failures))
+	for key, entry := range limiter.failures {
+		if limiter.forgotten(entry, now) {
+			continue
+		}
+		lockout := Lockout{Key: key, Failures: entry.count}
+		if now.Before(entry.lockedUntil) {
+			lockout.LockedUntil = entry.lockedUntil
+		}
+		lockouts = append(lockouts, lockout)
+	}
+	return lockouts
+}
+
+// forgotten - блокировка кончилась и новых неудач не было дольше Max
+func (limiter *MemoryLimiter) forgotten(entry *failures, now time.Time) bool {
+	return !now.Before(entry.lockedUntil) && now.Sub(entry.last) > limiter.policy.Max
+}
+
+// prune раз в минуту убирает полные запасы и забытые неудачи, чтобы карты не росли
+func (limiter *MemoryLimiter) prune(now time.Time) {
+	if now.Sub(limiter.lastPrune) < time.Minute {
+		return
+	}
+	limiter.lastPrune = now
+	for key, entry := range limiter.buckets {
+		if entry.tokens+now.Sub(entry.last).Minutes()*float64(limiter.policy.PerMinute) >= float64(limiter.policy.Burst) {
+			delete(limiter.buckets, key)
+		}
+	}
+	for key, entry := range limiter.failures {
+		if limiter.forgotten(entry, now) {
+			delete(limiter.failures, key)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package render
+
+import (
+	"container/list"
+	"pasteGo/backend/config"
+	"sync"
+)
+
+var (
+	instance *Cache
+	once     sync.Once
+)
+
+// GetCache возвращает общий кэш размером RENDER_CACHE_SIZE страниц
+func GetCache() *Cache {
+	once.Do(func() {
+		instance = NewCache(config.RenderCacheSize)
+	})
+	return instance
+}
+
+// Cache хранит последние результаты отрисовки. Ключ включает версию вставки,
+// поэтому после правки старая запись просто вытесняется
+type Cache struct {
+	mutex   sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type cacheEntry struct {
+	key  string
+	data any
+}
+
+func NewCache(size int) *Cache {
+	return &Cache{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+func (cache *Cache) Get(key string) (any, bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	element, ok := cache.entries[key]
+	if !ok {
+		return nil, false
+	}
+	cache.order.MoveToFront(element)
+	return element.Value.(*cacheEntry).data, true
+}
+
+func (cache *Cache) Put(key string, data any) {
+	if cache.size <= 0 {
+		return
+	}
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if element, ok := cache.entries[key]; ok {
+		element.Value.(*cacheEntry).data = data
+		cache.order.MoveToFront(element)
+		return
+	}
+	cache.entries[key] = cache.order.PushFront(&cacheEntry{key: key, data: data})
+	for cache.order.Len() > cache.size {
+		oldest := cache.order.Back()
+		cache.order.Remove(oldest)
+		delete(cache.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
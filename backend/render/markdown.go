@@ -0,0 +1,160 @@
+package render
+
+import (
+	"bytes"
+	"regexp"
+
+	"github.com/alecthomas/chroma/v2"
+	"github.com/alecthomas/chroma/v2/formatters/html"
+	"github.com/alecthomas/chroma/v2/lexers"
+	"github.com/alecthomas/chroma/v2/styles"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// Heading - пункт оглавления markdown-документа
+type Heading struct {
+	Level int
+	Id    string
+	Text  string
+}
+
+type Markdown struct {
+	HTML string
+	TOC  []Heading
+}
+
+// Префикс id заголовков, чтобы они не пересекались с id страницы, куда встроен документ
+const headingPrefix = "md-"
+
+var policy = newPolicy()
+
+// newPolicy - строгий санитайзер поверх UGC: без скриптов, обработчиков событий и
+// небезопасных ссылок. Разрешены только id заголовков, флажки списков задач и
+// inline-стили подсветки кода
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.RequireNoFollowOnLinks(true)
+	p.RequireNoReferrerOnLinks(true)
+	p.AddTargetBlankToFullyQualifiedLinks(true)
+	p.AllowAttrs("id").Matching(regexp.MustCompile(`^`+headingPrefix+`[\p{L}\p{N}_-]*$`)).
+		OnElements("h1", "h2", "h3", "h4", "h5", "h6")
+	p.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	p.AllowAttrs("checked", "disabled").Matching(regexp.MustCompile(`^$`)).OnElements("input")
+	p.AllowAttrs("tabindex").Matching(regexp.MustCompile(`^0$`)).OnElements("pre")
+	p.AllowStyles("color", "background-color", "font-weight", "font-style", "text-decoration").
+		OnElements("pre", "span")
+	p.AllowStyles("display").MatchingEnum("flex").OnElements("span")
+	return p
+}
+
+// RenderMarkdown переводит CommonMark/GFM в очищенный HTML с подсветкой
+// блоков кода и оглавлением по заголовкам
+func RenderMarkdown(source string, style string) (Markdown, error) {
+	md := goldmark.New(
+		goldmark.WithExtensions(extension.GFM),
+		goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+		goldmark.WithRendererOptions(
+			renderer.WithNodeRenderers(util.Prioritized(&codeBlockRenderer{style: styles.Get(style)}, 100)),
+		),
+	)
+
+	src := []byte(source)
+	doc := md.Parser().Parse(text.NewReader(src))
+
+	toc := make([]Heading, 0)
+	err := ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		heading, ok := node.(*ast.Heading)
+		if !entering || !ok {
+			return ast.WalkContinue, nil
+		}
+		id := ""
+		if value, ok := heading.AttributeString("id"); ok {
+			if raw, ok := value.([]byte); ok {
+				id = headingPrefix + string(raw)
+			}
+		}
+		heading.SetAttributeString("id", []byte(id))
+		toc = append(toc, Heading{
+			Level: heading.Level,
+			Id:    id,
+			Text:  plainText(heading, src),
+		})
+		return ast.WalkSkipChildren, nil
+	})
+	if err != nil {
+		return Markdown{}, err
+	}
+
+	var out bytes.Buffer
+	if err := md.Renderer().Render(&out, src, doc); err != nil {
+		return Markdown{}, err
+	}
+	return Markdown{
+		HTML: policy.Sanitize(out.String()),
+		TOC:  toc,
+	}, nil
+}
+
+func plainText(node ast.Node, source []byte) string {
+	var buf bytes.Buffer
+	_ = ast.Walk(node, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch n := n.(type) {
+		case *ast.Text:
+			buf.Write(n.Segment.Value(source))
+			if n.SoftLineBreak() {
+				buf.WriteByte(' ')
+			}
+		case *ast.String:
+			buf.Write(n.Value)
+		}
+		return ast.WalkContinue, nil
+	})
+	return buf.String()
+}
+
+// codeBlockRenderer подсвечивает блоки ```lang тем же chroma, что и /render
+type codeBlockRenderer struct {
+	style *chroma.Style
+}
+
+func (r *codeBlockRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindFencedCodeBlock, r.renderFencedCodeBlock)
+}
+
+func (r *codeBlockRenderer) renderFencedCodeBlock(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+	block := node.(*ast.FencedCodeBlock)
+
+	var code bytes.Buffer
+	lines := block.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		segment := lines.At(i)
+		code.Write(segment.Value(source))
+	}
+
+	//Блок без указания языка не угадывается, а показывается как текст
+	lexer := lexers.Fallback
+	if language := block.Language(source); language != nil {
+		lexer = Lexer(string(language), "", code.String())
+	}
+	iterator, err := chroma.Coalesce(lexer).Tokenise(nil, code.String())
+	if err != nil {
+		return ast.WalkStop, err
+	}
+	if err := html.New(html.TabWidth(4)).Format(w, r.style, iterator); err != nil {
+		return ast.WalkStop, err
+	}
+	return ast.WalkSkipChildren, nil
+}
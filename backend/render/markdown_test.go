@@ -0,0 +1,188 @@
+package render
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Элементы, которые не должны попадать в отрисованный документ ни в каком виде
+var forbiddenElements = map[string]bool{
+	"script": true, "iframe": true, "frame": true, "style": true, "object": true, "embed": true,
+	"form": true, "svg": true, "math": true, "base": true, "link": true, "meta": true,
+}
+
+// Атрибуты со ссылками: в них не должно быть исполняемых и data: схем
+var urlAttributes = map[string]bool{
+	"href": true, "src": true, "action": true, "formaction": true, "xlink:href": true,
+	"background": true, "poster": true, "cite": true, "srcset": true,
+}
+
+var (
+	headingId = regexp.MustCompile(`^` + headingPrefix + `[\p{L}\p{N}_-]*$`)
+	//Политика UGC пропускает id из этих символов: в таком значении атрибут не подделать
+	plainId = regexp.MustCompile(`^[a-zA-Z0-9:_.-]+$`)
+)
+
+// unsafeMarkup разбирает HTML так же, как браузер, и возвращает описание первой опасной находки.
+// id должны соответствовать ids
+func unsafeMarkup(t *testing.T, fragment string, ids *regexp.Regexp) string {
+	t.Helper()
+	nodes, err := html.ParseFragment(strings.NewReader(fragment), &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var check func(node *html.Node) string
+	check = func(node *html.Node) string {
+		if node.Type == html.ElementNode {
+			if forbiddenElements[node.Data] {
+				return "<" + node.Data + "> element"
+			}
+			for _, attr := range node.Attr {
+				name := strings.ToLower(attr.Key)
+				value := strings.ToLower(strings.Map(func(r rune) rune {
+					if r <= ' ' {
+						return -1
+					}
+					return r
+				}, attr.Val))
+				switch {
+				case strings.HasPrefix(name, "on"):
+					return name + " handler on <" + node.Data + ">"
+				case urlAttributes[name] && (strings.HasPrefix(value, "javascript:") || strings.HasPrefix(value, "vbscript:") || strings.HasPrefix(value, "data:")):
+					return name + "=" + attr.Val + " on <" + node.Data + ">"
+				case name == "style" && (strings.Contains(value, "url(") || strings.Contains(value, "expression(")):
+					return "style=" + attr.Val + " on <" + node.Data + ">"
+				case name == "id" && !ids.MatchString(attr.Val):
+					return "id=" + attr.Val + " on <" + node.Data + ">"
+				}
+			}
+		}
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			if found := check(child); found != "" {
+				return found
+			}
+		}
+		return ""
+	}
+	for _, node := range nodes {
+		if found := check(node); found != "" {
+			return found
+		}
+	}
+	return ""
+}
+
+func TestRenderMarkdownSanitizes(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		keep   string //Безопасная часть документа, которая должна остаться
+	}{
+		{"script block", "<script>alert(1)</script>\n\nafter", "after"},
+		{"inline script", "text <script>alert(1)</script> tail", "tail"},
+		{"script in list", "- <script src=https://evil.example/x.js></script>", ""},
+		{"javascript link", "[click](javascript:alert(1))", "click"},
+		{"mixed case javascript link", "[click](JaVaScRiPt:alert(1))", "click"},
+		{"entity encoded javascript link", "[click](jav&#x09;ascript:alert(1))", "click"},
+		{"javascript autolink", "<javascript:alert(1)>", ""},
+		{"javascript reference link", "[click][evil]\n\n[evil]: javascript:alert(1)", "click"},
+		{"javascript image", "![pic](javascript:alert(1))", ""},
+		{"vbscript link", "[click](vbscript:msgbox(1))", "click"},
+		{"img onerror", `<img src=x onerror=alert(1)>`, ""},
+		{"img onerror markdown", `![x](https://example.com/x.png" onerror="alert(1))`, ""},
+		{"anchor onclick", `<a href="#top" onclick="alert(1)">top</a>`, "top"},
+		{"svg onload", `<svg onload=alert(1)><circle/></svg>`, ""},
+		{"iframe", `<iframe src="https://evil.example"></iframe>`, ""},
+		{"iframe srcdoc", `<iframe srcdoc="<script>alert(1)</script>"></iframe>`, ""},
+		{"style element", "<style>body{background:red}</style>\n\ntext", "text"},
+		{"style attribute url", `<div style="background:url(javascript:alert(1))">x</div>`, ""},
+		{"object and embed", `<object data="x.swf"></object><embed src="x.swf">`, ""},
+		{"form action", `<form action="javascript:alert(1)"><button>go</button></form>`, ""},
+		{"meta refresh", `<meta http-equiv="refresh" content="0;url=javascript:alert(1)">`, ""},
+		{"base href", `<base href="https://evil.example/">`, ""},
+		{"data link", "[x](data:text/html;base64,PHNjcmlwdD5hbGVydCgxKTwvc2NyaXB0Pg==)", "x"},
+		{"data image", "![x](data:image/svg+xml;base64,PHN2ZyBvbmxvYWQ9YWxlcnQoMSk+)", ""},
+		{"data anchor", `<a href="data:text/html,<script>alert(1)</script>">x</a>`, "x"},
+		{"fence info attribute", "```js\" onmouseover=\"alert(1)\nlet a = 1\n```", "let"},
+		{"fence info tag", "```\"><script>alert(1)</script>\ncode\n```", "code"},
+		{"fence info img", "```<img/src=x/onerror=alert(1)>\ncode\n```", "code"},
+		{"tilde fence info", "~~~ python onload=alert(1)\nprint(1)\n~~~", "print"},
+		{"heading img", `# "><img src=x onerror=alert(1)>`, ""},
+		{"heading attribute text", `# Title" onclick="alert(1)`, "Title"},
+		{"heading attribute syntax", "## Section {#x onclick=alert(1)}", "Section"},
+		{"heading raw id", `<h2 id="main" onclick="alert(1)">Raw</h2>`, ""},
+		{"setext heading", "\"><script>alert(1)</script>\n===", ""},
+		{"task list", "- [x] <span onclick=alert(1)>done</span>", "done"},
+		{"table cell", "| a |\n|---|\n| <img src=x onerror=alert(1)> |", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md, err := RenderMarkdown(tt.source, "github")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if found := unsafeMarkup(t, md.HTML, headingId); found != "" {
+				t.Errorf("%s survived sanitizing:\n%s", found, md.HTML)
+			}
+			// Текст вида javascript: допустим, а теги - нет, даже если разбор их пропустил
+			lower := strings.ToLower(md.HTML)
+			for _, fragment := range []string{"<script", "<iframe", "<style"} {
+				if strings.Contains(lower, fragment) {
+					t.Errorf("%q survived sanitizing:\n%s", fragment, md.HTML)
+				}
+			}
+			if !strings.Contains(md.HTML, tt.keep) {
+				t.Errorf("safe content %q is lost:\n%s", tt.keep, md.HTML)
+			}
+
+			// goldmark сам выбрасывает сырой HTML, поэтому санитайзер проверяется и отдельно:
+			// он должен справиться, даже если сырой HTML однажды пропустят
+			if found := unsafeMarkup(t, policy.Sanitize(tt.source), plainId); found != "" {
+				t.Errorf("%s survived the policy:\n%s", found, policy.Sanitize(tt.source))
+			}
+		})
+	}
+}
+
+func TestRenderMarkdownHeadingIds(t *testing.T) {
+	md, err := RenderMarkdown("# Intro\n\n## \"><b>Setup</b>\n\n## Intro", "github")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(md.TOC) != 3 {
+		t.Fatalf("TOC = %+v", md.TOC)
+	}
+	seen := map[string]bool{}
+	for _, heading := range md.TOC {
+		if !headingId.MatchString(heading.Id) {
+			t.Errorf("heading id %q is not prefixed and sanitized", heading.Id)
+		}
+		if seen[heading.Id] {
+			t.Errorf("duplicate heading id %q", heading.Id)
+		}
+		seen[heading.Id] = true
+		if !strings.Contains(md.HTML, `id="`+heading.Id+`"`) {
+			t.Errorf("heading id %q is missing from the HTML:\n%s", heading.Id, md.HTML)
+		}
+	}
+	if md.TOC[1].Text != `">Setup` {
+		t.Errorf("TOC text = %q", md.TOC[1].Text)
+	}
+}
+
+func TestRenderMarkdownKeepsSafeLinks(t *testing.T) {
+	md, err := RenderMarkdown("[site](https://example.com) [top](#md-intro) ![logo](https://example.com/logo.png)", "github")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, fragment := range []string{`href="https://example.com"`, `rel="nofollow noreferrer"`, `href="#md-intro"`, `src="https://example.com/logo.png"`} {
+		if !strings.Contains(md.HTML, fragment) {
+			t.Errorf("%s is missing:\n%s", fragment, md.HTML)
+		}
+	}
+}
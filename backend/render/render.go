@@ -0,0 +1,160 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"strings"
+
+	"github.com/alecthomas/chroma/v2"
+	"github.com/alecthomas/chroma/v2/formatters/html"
+	"github.com/alecthomas/chroma/v2/lexers"
+	"github.com/alecthomas/chroma/v2/styles"
+)
+
+// Source - один подсвечиваемый текст: основной текст вставки или файл набора
+type Source struct {
+	Name     string
+	Language string
+	Text     string
+	Markdown bool //Текст отрисовывается как документ, а не как код
+}
+
+type Options struct {
+	Style    string
+	Fragment bool //Только разметка без <html>, для вставки в чужие страницы
+}
+
+type section struct {
+	Id       string
+	Name     string
+	Language string
+	Code     template.HTML
+	TOC      []Heading
+}
+
+var page = template.Must(template.New("page").Parse(`{{define "sections"}}<div class="pastego"{{if .Background}} style="background-color:{{.Background}}"{{end}}>
+{{if .Title}}<h1 class="pastego-title">{{.Title}}</h1>
+{{end}}{{range .Sections}}<section class="pastego-file" id="{{.Id}}">
+{{if .Name}}<h2 class="pastego-name"><a href="#{{.Id}}">{{.Name}}</a> <small>{{.Language}}</small></h2>
+{{end}}{{if .TOC}}<nav class="pastego-toc"><ul>
+{{range .TOC}}<li style="margin-left:{{.Level}}em"><a href="#{{.Id}}">{{.Text}}</a></li>
+{{end}}</ul></nav>
+{{end}}{{.Code}}
+</section>
+{{end}}</div>{{end}}{{if .Fragment}}{{template "sections" .}}{{else}}<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{if .Title}}{{.Title}}{{else}}pasteGo{{end}}</title>
+<style>body{margin:0}.pastego{font-family:sans-serif;min-height:100vh;padding:1em}.pastego pre{margin:0;overflow-x:auto}.pastego section{margin-bottom:1.5em}</style>
+</head>
+<body>
+{{template "sections" .}}
+</body>
+</html>
+{{end}}`))
+
+// Render подсвечивает тексты и собирает HTML со строками-якорями: #L5 для
+// основного текста и #<имя файла>-L5 для файлов набора
+func Render(title string, sources []Source, opts Options) ([]byte, error) {
+	style := styles.Get(opts.Style)
+
+	sections := make([]section, 0, len(sources))
+	for i := range sources {
+		id := "paste"
+		prefix := "L"
+		if sources[i].Name != "" {
+			id = anchor(sources[i].Name)
+			prefix = id + "-L"
+		}
+
+		if sources[i].Markdown {
+			doc, err := RenderMarkdown(sources[i].Text, opts.Style)
+			if err != nil {
+				return nil, err
+			}
+			sections = append(sections, section{
+				Id:       id,
+				Name:     sources[i].Name,
+				Language: "Markdown",
+				Code:     template.HTML(`<div class="pastego-markdown">` + doc.HTML + `</div>`),
+				TOC:      doc.TOC,
+			})
+			continue
+		}
+
+		lexer := Lexer(sources[i].Language, sources[i].Name, sources[i].Text)
+		iterator, err := lexer.Tokenise(nil, sources[i].Text)
+		if err != nil {
+			return nil, fmt.Errorf("tokenise %q: %w", sources[i].Name, err)
+		}
+
+		formatter := html.New(
+			html.WithLineNumbers(true),
+			html.LineNumbersInTable(true),
+			html.WithLinkableLineNumbers(true, prefix),
+			html.TabWidth(4),
+		)
+		var code bytes.Buffer
+		if err := formatter.Format(&code, style, iterator); err != nil {
+			return nil, err
+		}
+
+		sections = append(sections, section{
+			Id:       id,
+			Name:     sources[i].Name,
+			Language: lexer.Config().Name,
+			Code:     template.HTML(code.String()),
+		})
+	}
+
+	var out bytes.Buffer
+	err := page.Execute(&out, map[string]any{
+		"Title":      title,
+		"Sections":   sections,
+		"Fragment":   opts.Fragment,
+		"Background": background(style),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// Lexer выбирает подсветку: явно указанный язык, затем имя файла, затем анализ текста
+func Lexer(language string, filename string, text string) chroma.Lexer {
+	var lexer chroma.Lexer
+	if language != "" {
+		lexer = lexers.Get(language)
+	}
+	if lexer == nil && filename != "" {
+		lexer = lexers.Match(filename)
+	}
+	if lexer == nil {
+		lexer = lexers.Analyse(text)
+	}
+	if lexer == nil {
+		lexer = lexers.Fallback
+	}
+	return chroma.Coalesce(lexer)
+}
+
+func background(style *chroma.Style) string {
+	entry := style.Get(chroma.Background)
+	if !entry.Background.IsSet() {
+		return ""
+	}
+	return entry.Background.String()
+}
+
+// anchor превращает имя файла в значение id без пробелов и кавычек
+func anchor(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '_', r == '-':
+			return r
+		}
+		return '_'
+	}, name)
+}
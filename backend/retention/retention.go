@@ -0,0 +1,304 @@
+// Package retention удаляет вставки по сроку жизни и политикам хранения экземпляра.
+// Вставки под удержанием (legal hold) не удаляются ни владельцем, ни политиками,
+// каждое удаление и отказ записываются в журнал retention_events, удаления фоновой
+// проверкой - ещё и в журнал аудита
+package retention
+
+import (
+	"context"
+	"errors"
+	"log"
+	"pasteGo/backend/audit"
+	"pasteGo/backend/db"
+	"pasteGo/backend/db/typesDB"
+	"pasteGo/backend/expiry"
+	"pasteGo/backend/storage"
+	"sync"
+	"time"
+)
+
+// ActorJob - автор событий фоновой проверки
+const ActorJob = "retention"
+
+// Действия в журнале хранения
+const (
+	ActionExpire       = "expire"        //Истёк срок жизни вставки
+	ActionPurge        = "purge"         //Вставка удалена политикой
+	ActionDelete       = "delete"        //Вставку удалил владелец
+	ActionDeleteDenied = "delete_denied" //Удаление отклонено удержанием
+	ActionHold         = "hold"
+	ActionRelease      = "release"
+	ActionUserDelete   = "user_delete" //Аккаунт закрыт, вставки ждут удаления
+	ActionUserPurge    = "user_purge"  //Закрытый аккаунт удалён окончательно
+	ActionPolicyAdd    = "policy_add"
+	ActionPolicyDelete = "policy_delete"
+)
+
+// Report - итог одного прохода Run
+type Report struct {
+	Expired     int
+	Purged      int
+	UsersPurged int
+}
+
+// mu не даёт ручному запуску из API пересечься с фоновым
+var mu sync.Mutex
+
+// DeletePaste удаляет вставку вместе с данными вложений.
+// Для вставки под удержанием возвращается db.ErrLegalHold
+func DeletePaste(DBInstance *db.DBInstance, pasteId string) error {
+	attachments, err := DBInstance.GetAttachmentRecordsByPasteId(pasteId)
+	if err != nil {
+		return err
+	}
+	if err := DBInstance.DeletePasteRecord(pasteId); err != nil {
+		return err
+	}
+	DeleteBlobs(attachments)
+	return nil
+}
+
+// DeleteBlobs убирает данные вложений из хранилища. Записи в БД
+// к этому моменту уже удалены, ошибки хранилища только логируются
+func DeleteBlobs(records []typesDB.AttachmentRecord) {
+	if len(records) == 0 {
+		return
+	}
+	store, err := storage.GetBlobStore()
+	if err != nil {
+		log.Printf("blob store: %s", err)
+		return
+	}
+	for i := range records {
+		if err := store.Delete(context.Background(), records[i].Id); err != nil {
+			log.Printf("attachment %s: %s", records[i].Id, err)
+		}
+	}
+}
+
+// DeleteUser закрывает аккаунт. Вставки удаляются сразу, если нет политики deleted_users,
+// иначе - фоновой проверкой по её сроку; вставки команд остаются команде. Пока остаются
+// вставки (в том числе под удержанием), строка пользователя сохраняется под именем deleted-<id>
+func DeleteUser(DBInstance *db.DBInstance, user typesDB.UserRecord, actor string) error {
+	now := time.Now()
+	if err := DBInstance.MarkUserDeleted(user.Id, now.Unix()); err != nil {
+		return err
+	}
+	Record(DBInstance, typesDB.RetentionEventRecord{
+		Action: ActionUserDelete,
+		UserId: user.Id,
+		Actor:  actor,
+		Detail: user.Username,
+	})
+
+	policies, err := DBInstance.GetRetentionPolicyRecords()
+	if err != nil {
+		return err
+	}
+	if _, ok := deletedUsersPolicy(policies, now); ok {
+		return nil
+	}
+	if _, err := purgeUser(DBInstance, user.Id, ""); err != nil {
+		return err
+	}
+	_, err = purgeDeletedUser(DBInstance, user.Id)
+	return err
+}
+
+// Record добавляет событие в журнал. Ошибка записи журнала не прерывает операцию
+func Record(DBInstance *db.DBInstance, event typesDB.RetentionEventRecord) {
+	if event.Time == 0 {
+		event.Time = time.Now().Unix()
+	}
+	if err := DBInstance.AddRetentionEventRecord(&event); err != nil {
+		log.Printf("retention event %s: %s", event.Action, err)
+	}
+}
+
+// Run удаляет истёкшие вставки, применяет политики хранения и
+// окончательно удаляет закрытые аккаунты без вставок
+func Run(DBInstance *db.DBInstance, now time.Time) (Report, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	report := Report{}
+	expired, err := DBInstance.GetExpiredPasteRecords(now.Unix())
+	if err != nil {
+		return report, err
+	}
+	for i := range *expired {
+		if deleteRecorded(DBInstance, (*expired)[i], ActionExpire, "") {
+			report.Expired++
+		}
+	}
+
+	policies, err := DBInstance.GetRetentionPolicyRecords()
+	if err != nil {
+		return report, err
+	}
+	for i := range policies {
+		public, ok := scopePublic[policies[i].Scope]
+		if !ok {
+			continue
+		}
+		age, err := expiry.ParseDuration(policies[i].MaxAge)
+		if err != nil {
+			log.Printf("retention policy %s: %s", policies[i].Id, err)
+			continue
+		}
+		pastes, err := DBInstance.GetPasteRecordsCreatedBefore(age.SubtractFrom(now).Unix(), public)
+		if err != nil {
+			return report, err
+		}
+		for j := range *pastes {
+			if deleteRecorded(DBInstance, (*pastes)[j], ActionPurge, policies[i].Id) {
+				report.Purged++
+			}
+		}
+	}
+
+	users, err := DBInstance.GetDeletedUserRecords()
+	if err != nil {
+		return report, err
+	}
+	policy, hasPolicy := deletedUsersPolicy(policies, now)
+	for i := range users {
+		if !hasPolicy || users[i].Deleted < policy.cutoff {
+			purged, err := purgeUser(DBInstance, users[i].Id, policy.id)
+			if err != nil {
+				return report, err
+			}
+			report.Purged += purged
+		}
+		removed, err := purgeDeletedUser(DBInstance, users[i].Id)
+		if err != nil {
+			return report, err
+		}
+		if removed {
+			report.UsersPurged++
+		}
+	}
+	return report, nil
+}
+
+// Start запускает Run сразу и затем каждые interval. Нулевой интервал отключает проверку
+func Start(interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			DBInstance, err := db.GetDBInstance()
+			if err == nil {
+				var report Report
+				report, err = Run(DBInstance, time.Now())
+				if report != (Report{}) {
+					log.Printf("retention: expired %d, purged %d, users purged %d", report.Expired, report.Purged, report.UsersPurged)
+				}
+			}
+			if err != nil {
+				log.Printf("retention: %s", err)
+			}
+			<-ticker.C
+		}
+	}()
+}
+
+// Значение public для GetPasteRecordsCreatedBefore по области политики
+var scopePublic = map[string]int{
+	typesDB.RetentionScopeAll:     -1,
+	typesDB.RetentionScopePublic:  1,
+	typesDB.RetentionScopePrivate: 0,
+}
+
+type userPolicy struct {
+	id     string
+	cutoff int64
+}
+
+// deletedUsersPolicy выбирает среди политик deleted_users самую короткую
+func deletedUsersPolicy(policies []typesDB.RetentionPolicyRecord, now time.Time) (userPolicy, bool) {
+	found := userPolicy{}
+	ok := false
+	for i := range policies {
+		if policies[i].Scope != typesDB.RetentionScopeDeletedUsers {
+			continue
+		}
+		age, err := expiry.ParseDuration(policies[i].MaxAge)
+		if err != nil {
+			continue
+		}
+		cutoff := age.SubtractFrom(now).Unix()
+		if !ok || cutoff > found.cutoff {
+			found = userPolicy{id: policies[i].Id, cutoff: cutoff}
+			ok = true
+		}
+	}
+	return found, ok
+}
+
+func purgeUser(DBInstance *db.DBInstance, userId string, policyId string) (int, error) {
+	pastes, err := DBInstance.GetRemovablePasteRecordsByUserId(userId)
+	if err != nil {
+		return 0, err
+	}
+	purged := 0
+	for i := range *pastes {
+		if deleteRecorded(DBInstance, (*pastes)[i], ActionPurge, policyId) {
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+func purgeDeletedUser(DBInstance *db.DBInstance, userId string) (bool, error) {
+	removed, err := DBInstance.PurgeDeletedUser(userId)
+	if err != nil || !removed {
+		return false, err
+	}
+	Record(DBInstance, typesDB.RetentionEventRecord{
+		Action: ActionUserPurge,
+		UserId: userId,
+		Actor:  ActorJob,
+	})
+	audit.Record(DBInstance, audit.Event{
+		Actor:  ActorJob,
+		Action: audit.ActionUserPurge,
+		Target: audit.UserTarget(userId),
+	})
+	return true, nil
+}
+
+func deleteRecorded(DBInstance *db.DBInstance, paste typesDB.PasteRecord, action string, policyId string) bool {
+	err := DeletePaste(DBInstance, paste.Id)
+	if errors.Is(err, db.ErrLegalHold) {
+		return false
+	}
+	if err != nil {
+		log.Printf("retention: paste %s: %s", paste.Id, err)
+		return false
+	}
+	Record(DBInstance, typesDB.RetentionEventRecord{
+		Action:   action,
+		PolicyId: policyId,
+		PasteId:  paste.Id,
+		UserId:   paste.UserId,
+		Actor:    ActorJob,
+		Detail:   paste.Title,
+	})
+	event := audit.Event{
+		Actor:  ActorJob,
+		Action: audit.ActionPastePurge,
+		Target: audit.PasteTarget(paste.Id),
+	}
+	if action == ActionExpire {
+		event.Action = audit.ActionPasteExpire
+	}
+	if policyId != "" {
+		event.Detail = audit.PolicyTarget(policyId)
+	}
+	audit.Record(DBInstance, event)
+	return true
+}
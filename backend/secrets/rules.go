@@ -0,0 +1,57 @@
+package secrets
+
+import "regexp"
+
+func defaultRules() []Rule {
+	return []Rule{
+		{
+			Id:          "aws-access-key-id",
+			Description: "AWS access key ID",
+			Pattern:     regexp.MustCompile(`\b(?:AKIA|ASIA|ABIA|ACCA)[0-9A-Z]{16}\b`),
+		},
+		{
+			Id:          "aws-secret-access-key",
+			Description: "AWS secret access key",
+			Pattern:     regexp.MustCompile(`(?i)aws.{0,20}(?:secret|private).{0,20}?['"=:\s]+([A-Za-z0-9/+]{40})\b`),
+			Group:       1,
+			MinEntropy:  4,
+		},
+		{
+			Id:          "private-key",
+			Description: "Private key",
+			Pattern:     regexp.MustCompile(`(?s)-----BEGIN (?:[A-Z]+ )*PRIVATE KEY(?: BLOCK)?-----.*?(?:-----END (?:[A-Z]+ )*PRIVATE KEY(?: BLOCK)?-----|\z)`),
+		},
+		{
+			Id:          "jwt",
+			Description: "JSON Web Token",
+			Pattern:     regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{8,}\.eyJ[A-Za-z0-9_-]{8,}\.[A-Za-z0-9_-]{8,}`),
+		},
+		{
+			Id:          "github-token",
+			Description: "GitHub token",
+			Pattern:     regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{60,})\b`),
+		},
+		{
+			Id:          "slack-token",
+			Description: "Slack token",
+			Pattern:     regexp.MustCompile(`\bxox[abposr]-[A-Za-z0-9-]{10,}`),
+		},
+		{
+			Id:          "stripe-key",
+			Description: "Stripe live key",
+			Pattern:     regexp.MustCompile(`\b[rs]k_live_[A-Za-z0-9]{20,}\b`),
+		},
+		{
+			Id:          "google-api-key",
+			Description: "Google API key",
+			Pattern:     regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}\b`),
+		},
+		{
+			Id:          "generic-secret",
+			Description: "Password or secret assignment",
+			Pattern:     regexp.MustCompile(`(?i)\b(?:password|passwd|pwd|secret|token|api[_-]?key|access[_-]?key)["']?\s*[:=]\s*["']?([^\s"';,]{12,})`),
+			Group:       1,
+			MinEntropy:  3.5,
+		},
+	}
+}
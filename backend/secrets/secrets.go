@@ -0,0 +1,192 @@
+package secrets
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Rule - шаблон секрета. Если задан MinEntropy, совпадение в группе Group
+// (0 - всё совпадение) должно быть достаточно случайным, иначе это не секрет
+type Rule struct {
+	Id          string
+	Description string
+	Pattern     *regexp.Regexp
+	Group       int
+	MinEntropy  float64
+}
+
+// Finding - найденный секрет. Сам секрет не возвращается, только его замаскированный вид
+type Finding struct {
+	Rule        string
+	Description string
+	File        string
+	StartLine   int
+	EndLine     int
+	Redacted    string
+}
+
+var (
+	rules = defaultRules()
+	mutex sync.RWMutex
+)
+
+// Register добавляет правило или заменяет правило с тем же Id
+func Register(rule Rule) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	for i := range rules {
+		if rules[i].Id == rule.Id {
+			rules[i] = rule
+			return
+		}
+	}
+	rules = append(rules, rule)
+}
+
+type fileRule struct {
+	Id          string  `json:"id"`
+	Description string  `json:"description"`
+	Pattern     string  `json:"pattern"`
+	Group       int     `json:"group"`
+	MinEntropy  float64 `json:"minEntropy"`
+}
+
+// LoadRules регистрирует дополнительные правила из JSON-файла вида
+// [{"id": "...", "description": "...", "pattern": "...", "group": 1, "minEntropy": 3.5}]
+func LoadRules(path string) error {
+	if path == "" {
+		return nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var fileRules []fileRule
+	if err := json.Unmarshal(data, &fileRules); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	for i := range fileRules {
+		pattern, err := regexp.Compile(fileRules[i].Pattern)
+		if err != nil {
+			return fmt.Errorf("%s: rule %q: %w", path, fileRules[i].Id, err)
+		}
+		if fileRules[i].Id == "" || fileRules[i].Group > pattern.NumSubexp() {
+			return fmt.Errorf("%s: rule %d is invalid", path, i)
+		}
+		Register(Rule{
+			Id:          fileRules[i].Id,
+			Description: fileRules[i].Description,
+			Pattern:     pattern,
+			Group:       fileRules[i].Group,
+			MinEntropy:  fileRules[i].MinEntropy,
+		})
+	}
+	return nil
+}
+
+// Scan ищет секреты в тексте, file - имя файла набора или пустая строка.
+// Фрагмент, уже найденный более ранним правилом, повторно не сообщается
+func Scan(file string, text string) []Finding {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	findings := make([]Finding, 0)
+	spans := make([][2]int, 0)
+	var lines []int
+	for _, rule := range rules {
+		for _, match := range rule.Pattern.FindAllStringSubmatchIndex(text, -1) {
+			start, end := match[2*rule.Group], match[2*rule.Group+1]
+			if start < 0 {
+				continue
+			}
+			secret := text[start:end]
+			if rule.MinEntropy > 0 && Entropy(secret) < rule.MinEntropy {
+				continue
+			}
+			if overlaps(spans, start, end) {
+				continue
+			}
+			spans = append(spans, [2]int{start, end})
+			if lines == nil {
+				lines = lineOffsets(text)
+			}
+			findings = append(findings, Finding{
+				Rule:        rule.Id,
+				Description: rule.Description,
+				File:        file,
+				StartLine:   lineAt(lines, start),
+				EndLine:     lineAt(lines, max(start, end-1)),
+				Redacted:    redact(secret),
+			})
+		}
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].StartLine < findings[j].StartLine
+	})
+	return findings
+}
+
+func overlaps(spans [][2]int, start int, end int) bool {
+	for _, span := range spans {
+		if start < span[1] && span[0] < end {
+			return true
+		}
+	}
+	return false
+}
+
+// Entropy - энтропия Шеннона строки в битах на символ
+func Entropy(value string) float64 {
+	if value == "" {
+		return 0
+	}
+	counts := make(map[rune]int)
+	total := 0
+	for _, r := range value {
+		counts[r]++
+		total++
+	}
+	var entropy float64
+	for _, count := range counts {
+		p := float64(count) / float64(total)
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+// lineOffsets возвращает смещения начала каждой строки
+func lineOffsets(text string) []int {
+	offsets := []int{0}
+	for i := 0; i < len(text); i++ {
+		if text[i] == '\n' {
+			offsets = append(offsets, i+1)
+		}
+	}
+	return offsets
+}
+
+// lineAt переводит смещение в номер строки, начиная с 1
+func lineAt(offsets []int, offset int) int {
+	return sort.Search(len(offsets), func(i int) bool { return offsets[i] > offset })
+}
+
+func redact(secret string) string {
+	secret = strings.TrimSpace(secret)
+	if strings.Contains(secret, "\n") {
+		secret, _, _ = strings.Cut(secret, "\n")
+		return secret + "\n..."
+	}
+	if len(secret) < 12 {
+		return strings.Repeat("*", len(secret))
+	}
+	return secret[:4] + strings.Repeat("*", len(secret)-8) + secret[len(secret)-4:]
+}
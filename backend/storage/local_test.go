@@ -0,0 +1,88 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLocalStorePath(t *testing.T) {
+	store := &LocalStore{root: "/blobs"}
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{"3f2a9c", "/blobs/3f/3f2a9c"},
+		{"", ""},
+		{"ab", ""},
+		{"../../etc/passwd", ""},
+		{"..", ""},
+		{"abc/def", ""},
+		{"ab\\..\\..\\x", ""},
+		{"abc.tmp", ""},
+		{"/etc/passwd", ""},
+	}
+	for _, tt := range tests {
+		got, err := store.path(tt.key)
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("path(%q) = %q, want an error", tt.key, got)
+			}
+			continue
+		}
+		if err != nil || got != filepath.FromSlash(tt.want) {
+			t.Errorf("path(%q) = %q, %v, want %q", tt.key, got, err, tt.want)
+		}
+	}
+}
+
+func TestLocalStore(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	store, err := NewLocalStore(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.Put(ctx, "abc123", strings.NewReader("hello"), 5, "text/plain"); err != nil {
+		t.Fatal(err)
+	}
+	r, err := store.Get(ctx, "abc123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(r)
+	r.Close()
+	if err != nil || string(data) != "hello" {
+		t.Fatalf("Get = %q, %v", data, err)
+	}
+
+	// Оборванная загрузка не оставляет ни блоба, ни временного файла
+	if err := store.Put(ctx, "abc456", strings.NewReader("hel"), 5, "text/plain"); err == nil {
+		t.Fatal("Put accepted a short body")
+	}
+	if _, err := store.Get(ctx, "abc456"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get after a failed Put: err = %v, want ErrNotFound", err)
+	}
+	entries, err := os.ReadDir(filepath.Join(root, "ab"))
+	if err != nil || len(entries) != 1 {
+		t.Errorf("blob directory holds %d entries, want only abc123", len(entries))
+	}
+
+	if err := store.Delete(ctx, "abc123"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Get(ctx, "abc123"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get after Delete: err = %v, want ErrNotFound", err)
+	}
+	if err := store.Delete(ctx, "abc123"); err != nil {
+		t.Errorf("Delete of a missing blob: %v", err)
+	}
+	if err := store.Put(ctx, "../escape", strings.NewReader("x"), 1, "text/plain"); err == nil {
+		t.Error("Put accepted a traversal key")
+	}
+}
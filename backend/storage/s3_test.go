@@ -0,0 +1,210 @@
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"pasteGo/backend/config"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	testAccessKey = "minio"
+	testSecretKey = "minio-secret"
+	testRegion    = "us-east-1"
+	testBucket    = "pastes"
+)
+
+// fakeS3 - заглушка S3-совместимого хранилища: один бакет в памяти, каждый запрос
+// проверяется по подписи AWS Signature V4, посчитанной на стороне сервера
+type fakeS3 struct {
+	mutex   sync.Mutex
+	objects map[string]fakeObject
+}
+
+type fakeObject struct {
+	data        []byte
+	contentType string
+}
+
+func (s *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.authorized(r) {
+		http.Error(w, "<Error><Code>SignatureDoesNotMatch</Code></Error>", http.StatusForbidden)
+		return
+	}
+
+	//Бакет - либо поддомен, либо первый сегмент пути
+	key := strings.TrimPrefix(r.URL.Path, "/")
+	if host, _, _ := strings.Cut(r.Host, ":"); !strings.HasPrefix(host, testBucket+".") {
+		bucket, rest, _ := strings.Cut(key, "/")
+		if bucket != testBucket {
+			http.Error(w, "<Error><Code>NoSuchBucket</Code></Error>", http.StatusNotFound)
+			return
+		}
+		key = rest
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.objects[key] = fakeObject{data: data, contentType: r.Header.Get("Content-Type")}
+	case http.MethodGet:
+		object, ok := s.objects[key]
+		if !ok {
+			http.Error(w, "<Error><Code>NoSuchKey</Code></Error>", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", object.contentType)
+		w.Write(object.data)
+	case http.MethodDelete:
+		delete(s.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *fakeS3) authorized(r *http.Request) bool {
+	amzDate := r.Header.Get("X-Amz-Date")
+	payloadHash := r.Header.Get("X-Amz-Content-Sha256")
+	if len(amzDate) != len("20060102T150405Z") || payloadHash == "" {
+		return false
+	}
+	scope := amzDate[:8] + "/" + testRegion + "/s3/aws4_request"
+	canonicalRequest := r.Method + "\n" + r.URL.EscapedPath() + "\n" + r.URL.RawQuery + "\n" +
+		"host:" + r.Host + "\n" + "x-amz-content-sha256:" + payloadHash + "\n" + "x-amz-date:" + amzDate + "\n\n" +
+		"host;x-amz-content-sha256;x-amz-date\n" + payloadHash
+	hashed := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(hashed[:])
+
+	key := []byte("AWS4" + testSecretKey)
+	for _, part := range []string{amzDate[:8], testRegion, "s3", "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	want := "AWS4-HMAC-SHA256 Credential=" + testAccessKey + "/" + scope +
+		", SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=" + hex.EncodeToString(hmacSHA256(key, stringToSign))
+	return r.Header.Get("Authorization") == want
+}
+
+func newTestS3Store(t *testing.T, pathStyle bool, secretKey string) (*S3Store, *fakeS3) {
+	t.Helper()
+	fake := &fakeS3{objects: map[string]fakeObject{}}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	endpoint := server.URL
+	if !pathStyle {
+		endpoint = strings.Replace(server.URL, "127.0.0.1", "s3.localhost", 1)
+	}
+	store, err := NewS3Store(config.S3Config{
+		Endpoint:  endpoint,
+		Region:    testRegion,
+		Bucket:    testBucket,
+		AccessKey: testAccessKey,
+		SecretKey: secretKey,
+		PathStyle: pathStyle,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Имена вида pastes.s3.localhost ведут на заглушку
+	address := server.Listener.Addr().String()
+	store.client = &http.Client{
+		Timeout: 5 * time.Second,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, network, address)
+			},
+		},
+	}
+	return store, fake
+}
+
+func TestS3Store(t *testing.T) {
+	for _, pathStyle := range []bool{true, false} {
+		name := "virtual-hosted"
+		if pathStyle {
+			name = "path-style"
+		}
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			store, fake := newTestS3Store(t, pathStyle, testSecretKey)
+
+			if err := store.Put(ctx, "3f2a9c", strings.NewReader("blob data"), 9, "image/png"); err != nil {
+				t.Fatal(err)
+			}
+			if object := fake.objects["3f2a9c"]; string(object.data) != "blob data" || object.contentType != "image/png" {
+				t.Fatalf("stored object = %q, %q", object.data, object.contentType)
+			}
+			if err := store.Put(ctx, "empty0", strings.NewReader(""), 0, "text/plain"); err != nil {
+				t.Fatalf("Put of an empty blob: %v", err)
+			}
+
+			r, err := store.Get(ctx, "3f2a9c")
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := io.ReadAll(r)
+			r.Close()
+			if err != nil || string(data) != "blob data" {
+				t.Fatalf("Get = %q, %v", data, err)
+			}
+
+			if _, err := store.Get(ctx, "missing"); !errors.Is(err, ErrNotFound) {
+				t.Errorf("Get of a missing key: err = %v, want ErrNotFound", err)
+			}
+			if err := store.Delete(ctx, "3f2a9c"); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := store.Get(ctx, "3f2a9c"); !errors.Is(err, ErrNotFound) {
+				t.Errorf("Get after Delete: err = %v, want ErrNotFound", err)
+			}
+			if err := store.Delete(ctx, "3f2a9c"); err != nil {
+				t.Errorf("Delete of a missing key: %v", err)
+			}
+		})
+	}
+}
+
+func TestS3StoreBadSignature(t *testing.T) {
+	ctx := context.Background()
+	store, fake := newTestS3Store(t, true, "wrong-secret")
+
+	err := store.Put(ctx, "3f2a9c", strings.NewReader("x"), 1, "text/plain")
+	if err == nil || !strings.Contains(err.Error(), "403") {
+		t.Fatalf("Put with a wrong secret: err = %v, want 403", err)
+	}
+	if len(fake.objects) != 0 {
+		t.Error("object stored despite a bad signature")
+	}
+	// 403 - не отсутствие блоба: ошибка не должна превращаться в ErrNotFound
+	if _, err := store.Get(ctx, "3f2a9c"); err == nil || errors.Is(err, ErrNotFound) {
+		t.Errorf("Get with a wrong secret: err = %v", err)
+	}
+}
+
+func TestNewS3StoreConfig(t *testing.T) {
+	for _, cfg := range []config.S3Config{
+		{Bucket: testBucket},
+		{Endpoint: "http://localhost:9000"},
+		{Endpoint: "localhost:9000", Bucket: testBucket},
+	} {
+		if _, err := NewS3Store(cfg); err == nil {
+			t.Errorf("NewS3Store(%+v) accepted an incomplete config", cfg)
+		}
+	}
+}
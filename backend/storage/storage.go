@@ -0,0 +1,49 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"pasteGo/backend/config"
+	"sync"
+)
+
+var ErrNotFound = errors.New("blob not found")
+
+// BlobStore хранит бинарные данные вложений по ключу
+type BlobStore interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+var (
+	instance BlobStore
+	mutex    sync.Mutex
+)
+
+// GetBlobStore создаёт хранилище, выбранное в настройках (BLOB_STORE)
+func GetBlobStore() (BlobStore, error) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if instance != nil {
+		return instance, nil
+	}
+
+	var err error
+	switch config.BlobStore {
+	case "local":
+		instance, err = NewLocalStore(config.BlobDir)
+	case "s3":
+		instance, err = NewS3Store(config.S3)
+	default:
+		err = fmt.Errorf("unknown blob store %q", config.BlobStore)
+	}
+	if err != nil {
+		instance = nil
+		return nil, err
+	}
+	return instance, nil
+}
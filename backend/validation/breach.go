@@ -0,0 +1,136 @@
+package validation
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"strings"
+)
+
+// Длина префикса SHA-1, по которому ищется диапазон, как в Pwned Passwords range API
+const breachPrefixLength = 5
+
+// BreachList - файл SHA-1 хешей утёкших паролей, отсортированный по хешу, по одному
+// в строке: "HASH" или "HASH:COUNT" (формат выгрузки Pwned Passwords). Файл не читается
+// целиком: двоичным поиском находится диапазон строк с тем же префиксом хеша,
+// и уже в нём сравнивается остаток
+type BreachList struct {
+	file *os.File
+	size int64
+}
+
+func OpenBreachList(path string) (*BreachList, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	if info.IsDir() {
+		file.Close()
+		return nil, errors.New("is a directory")
+	}
+	return &BreachList{file: file, size: info.Size()}, nil
+}
+
+func (list *BreachList) Close() error {
+	return list.file.Close()
+}
+
+// Contains сообщает, есть ли пароль в списке
+func (list *BreachList) Contains(password string) (bool, error) {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	suffixes, err := list.Range(hash[:breachPrefixLength])
+	if err != nil {
+		return false, err
+	}
+	for _, suffix := range suffixes {
+		if suffix == hash[breachPrefixLength:] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Range возвращает остатки хешей, начинающихся с prefix
+func (list *BreachList) Range(prefix string) ([]string, error) {
+	prefix = strings.ToUpper(prefix)
+
+	//Наименьшее смещение, с которого первая целая строка уже не меньше префикса
+	low, high := int64(0), list.size
+	for low < high {
+		middle := low + (high-low)/2
+		hash, _, err := list.hashAfter(middle)
+		if err != nil {
+			return nil, err
+		}
+		if hash == "" || hash >= prefix {
+			high = middle
+		} else {
+			low = middle + 1
+		}
+	}
+
+	_, start, err := list.hashAfter(low)
+	if err != nil {
+		return nil, err
+	}
+	reader := bufio.NewReader(io.NewSectionReader(list.file, start, list.size-start))
+	suffixes := make([]string, 0)
+	for {
+		line, err := reader.ReadString('\n')
+		hash := lineHash(line)
+		if strings.HasPrefix(hash, prefix) {
+			suffixes = append(suffixes, hash[len(prefix):])
+		} else if hash != "" {
+			break
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return suffixes, nil
+}
+
+// hashAfter читает первую целую строку, начинающуюся не раньше offset.
+// Пустой хеш - такой строки нет
+func (list *BreachList) hashAfter(offset int64) (string, int64, error) {
+	start := offset
+	if offset > 0 {
+		start = offset - 1
+	}
+	reader := bufio.NewReader(io.NewSectionReader(list.file, start, list.size-start))
+	if offset > 0 {
+		//Байт перед offset - перевод строки, значит строка начинается ровно с offset
+		skipped, err := reader.ReadString('\n')
+		if err == io.EOF {
+			return "", list.size, nil
+		}
+		if err != nil {
+			return "", 0, err
+		}
+		start += int64(len(skipped))
+	}
+
+	line, err := reader.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", 0, err
+	}
+	return lineHash(line), start, nil
+}
+
+func lineHash(line string) string {
+	hash, _, _ := strings.Cut(strings.TrimSpace(line), ":")
+	return strings.ToUpper(hash)
+}
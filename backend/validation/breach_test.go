@@ -0,0 +1,168 @@
+package validation
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func openTestBreachList(t *testing.T, content string) *BreachList {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "breach.txt")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	list, err := OpenBreachList(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { list.Close() })
+	return list
+}
+
+// bruteRange - эталон для Range: полный просмотр всех строк
+func bruteRange(content string, prefix string) []string {
+	suffixes := make([]string, 0)
+	for _, line := range strings.Split(content, "\n") {
+		if hash := lineHash(line); strings.HasPrefix(hash, strings.ToUpper(prefix)) {
+			suffixes = append(suffixes, hash[len(prefix):])
+		}
+	}
+	return suffixes
+}
+
+func hashLine(password string, count int) string {
+	sum := sha1.Sum([]byte(password))
+	return fmt.Sprintf("%s:%d", strings.ToUpper(hex.EncodeToString(sum[:])), count)
+}
+
+func TestBreachListRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		prefix  string
+		want    []string
+	}{
+		{"empty file", "", "AAAAA", []string{}},
+		{"one line", "AAAAA111:3\n", "AAAAA", []string{"111"}},
+		{"one line without a match", "AAAAA111:3\n", "AAAAB", []string{}},
+		{"one line, prefix sorts before", "BBBBB111:3\n", "AAAAA", []string{}},
+		{"one line without a trailing newline", "AAAAA111:3", "AAAAA", []string{"111"}},
+		{"match on the first line", "AAAAA111:1\nBBBBB222:2\nCCCCC333:3\n", "AAAAA", []string{"111"}},
+		{"match on the last line", "AAAAA111:1\nBBBBB222:2\nCCCCC333:3\n", "CCCCC", []string{"333"}},
+		{"match on the last line without a trailing newline", "AAAAA111:1\nBBBBB222:2\nCCCCC333:3", "CCCCC", []string{"333"}},
+		{"several matches at the end without a trailing newline", "AAAAA111:1\nCCCCC333:3\nCCCCC444:4", "CCCCC", []string{"333", "444"}},
+		{"CRLF", "AAAAA111:1\r\nBBBBB222:2\r\nBBBBB223:1\r\nCCCCC333:3\r\n", "BBBBB", []string{"222", "223"}},
+		{"CRLF on the last line", "AAAAA111:1\r\nBBBBB222:2\r\nCCCCC333:3\r\n", "CCCCC", []string{"333"}},
+		{"CRLF on the first line", "AAAAA111:1\r\nBBBBB222:2\r\n", "AAAAA", []string{"111"}},
+		{"hashes without counts", "AAAAA111\nBBBBB222\nCCCCC333\n", "BBBBB", []string{"222"}},
+		{"lower case file", "aaaaa111:1\nbbbbb222:2\n", "BBBBB", []string{"222"}},
+		{"lower case prefix", "AAAAA111:1\nBBBBB222:2\n", "bbbbb", []string{"222"}},
+		{"prefix between lines", "AAAAA111:1\nCCCCC333:3\n", "BBBBB", []string{}},
+		{"prefix after every line", "AAAAA111:1\nBBBBB222:2\n", "FFFFF", []string{}},
+		{"trailing blank lines", "AAAAA111:1\nBBBBB222:2\n\n\n", "BBBBB", []string{"222"}},
+		{"blank line in the middle", "AAAAA111:1\n\nBBBBB222:2\n\nCCCCC333:3\n", "BBBBB", []string{"222"}},
+		//Совпадения занимают середину файла: первая проба двоичного поиска попадает внутрь диапазона
+		{"matches straddle the midpoint", "AAAAA111:1\nBBBBB001:1\nBBBBB002:1\nBBBBB003:1\nBBBBB004:1\nCCCCC333:3\n", "BBBBB", []string{"001", "002", "003", "004"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := openTestBreachList(t, tt.content)
+			got, err := list.Range(tt.prefix)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Range(%q) = %q, want %q", tt.prefix, got, tt.want)
+			}
+		})
+	}
+}
+
+// Диапазон вокруг середины файла при любой длине строк и любом положении пробы
+func TestBreachListRangeStraddlesMidpoint(t *testing.T) {
+	for _, newline := range []string{"\n", "\r\n"} {
+		for before := 0; before < 6; before++ {
+			for matches := 1; matches < 6; matches++ {
+				lines := make([]string, 0)
+				for i := 0; i < before; i++ {
+					lines = append(lines, fmt.Sprintf("0%04X%s:%d", i, strings.Repeat("A", 30+i), i))
+				}
+				for i := 0; i < matches; i++ {
+					lines = append(lines, fmt.Sprintf("7BEEF%0*d:%d", 35-i*7, i, i))
+				}
+				for i := 0; i < 6-before; i++ {
+					lines = append(lines, fmt.Sprintf("F%04X%s", i, strings.Repeat("B", 35)))
+				}
+				for _, trailing := range []string{newline, ""} {
+					content := strings.Join(lines, newline) + trailing
+					list := openTestBreachList(t, content)
+					for _, prefix := range []string{"7BEEF", "0", "00000", "F0005", "7BEEE", "7BEF0"} {
+						got, err := list.Range(prefix)
+						if err != nil {
+							t.Fatal(err)
+						}
+						if want := bruteRange(content, prefix); !slices.Equal(got, want) {
+							t.Errorf("Range(%q) of %q = %q, want %q", prefix, content, got, want)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestBreachListHashAfter(t *testing.T) {
+	for _, content := range []string{
+		"",
+		"AAAAA111:3",
+		"AAAAA111:3\n",
+		"AAAAA111:1\r\nBBBBB222:2\r\nCCCCC333:3",
+		"AAAAA111:1\nBBBBB222:2\nCCCCC333:3\n",
+	} {
+		list := openTestBreachList(t, content)
+		for offset := int64(0); offset <= int64(len(content)); offset++ {
+			hash, start, err := list.hashAfter(offset)
+			if err != nil {
+				t.Fatal(err)
+			}
+			//Первая строка, которая начинается не раньше offset
+			wantStart := int64(len(content))
+			if offset == 0 {
+				wantStart = 0
+			} else if i := strings.IndexByte(content[offset-1:], '\n'); i >= 0 {
+				wantStart = offset + int64(i)
+			}
+			wantHash := ""
+			if wantStart < int64(len(content)) {
+				line, _, _ := strings.Cut(content[wantStart:], "\n")
+				wantHash = lineHash(line)
+			}
+			if hash != wantHash || (wantHash != "" && start != wantStart) {
+				t.Errorf("hashAfter(%d) of %q = %q, %d, want %q, %d", offset, content, hash, start, wantHash, wantStart)
+			}
+		}
+	}
+}
+
+func TestBreachListContains(t *testing.T) {
+	lines := []string{hashLine("password", 3861493), hashLine("123456", 37359195), hashLine("qwerty", 10556095), hashLine("correct horse", 1)}
+	slices.Sort(lines)
+	list := openTestBreachList(t, strings.Join(lines, "\r\n"))
+
+	for _, password := range []string{"password", "123456", "qwerty", "correct horse"} {
+		if found, err := list.Contains(password); err != nil || !found {
+			t.Errorf("Contains(%q) = %t, %v", password, found, err)
+		}
+	}
+	for _, password := range []string{"", "Password", "correct horse battery staple"} {
+		if found, err := list.Contains(password); err != nil || found {
+			t.Errorf("Contains(%q) = %t, %v", password, found, err)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package validation
+
+import (
+	"fmt"
+	"log"
+	"net/mail"
+	"pasteGo/backend/config"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Поля, к которым относятся нарушения
+const (
+	FieldUsername = "username"
+	FieldPassword = "password"
+	FieldEmail    = "email"
+	FieldTeamName = "name"
+)
+
+// Нарушенные правила
+const (
+	RuleRequired = "required"
+	RuleLength   = "length"
+	RuleCharset  = "charset"
+	RuleReserved = "reserved"
+	RuleFormat   = "format"
+	RuleWeak     = "weak"
+	RuleBreached = "breached"
+)
+
+const maxEmailLength = 254
+
+const maxTeamNameLength = 64
+
+// Пароль из меньшего числа разных символов ("aaaaaaaa", "abababab") слишком слабый при любой длине
+const minDistinctRunes = 4
+
+// Violation - нарушенное правило поля, Message можно показать пользователю
+type Violation struct {
+	Field   string
+	Rule    string
+	Message string
+}
+
+var (
+	usernamePattern *regexp.Regexp
+	breaches        *BreachList
+	mutex           sync.RWMutex
+)
+
+// Load применяет настройки: USERNAME_PATTERN и PASSWORD_BREACH_LIST
+func Load() error {
+	pattern, err := regexp.Compile(config.UsernamePattern)
+	if err != nil {
+		return fmt.Errorf("USERNAME_PATTERN: %w", err)
+	}
+
+	var list *BreachList
+	if config.PasswordBreachList != "" {
+		if list, err = OpenBreachList(config.PasswordBreachList); err != nil {
+			return fmt.Errorf("PASSWORD_BREACH_LIST: %w", err)
+		}
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	usernamePattern = pattern
+	if breaches != nil {
+		breaches.Close()
+	}
+	breaches = list
+	return nil
+}
+
+// NormalizeUsername приводит имя к форме NFKC: "ｂob" и "bob" - одно и то же имя
+func NormalizeUsername(username string) string {
+	return norm.NFKC.String(username)
+}
+
+// UsernameKey - ключ уникальности имени: нормализованное имя без учёта регистра
+func UsernameKey(username string) string {
+	return cases.Fold().String(NormalizeUsername(username))
+}
+
+// TeamKey - ключ уникальности имени команды, сравнивается так же, как имена пользователей
+func TeamKey(name string) string {
+	return UsernameKey(name)
+}
+
+// Username нормализует имя и проверяет его по правилам USERNAME_*
+func Username(username string) (string, []Violation) {
+	username = NormalizeUsername(username)
+	if username == "" {
+		return "", []Violation{{FieldUsername, RuleRequired, "username is required"}}
+	}
+
+	violations := make([]Violation, 0)
+	length := int64(utf8.RuneCountInString(username))
+	if length < config.UsernameMinLength || length > config.UsernameMaxLength {
+		violations = append(violations, Violation{FieldUsername, RuleLength,
+			fmt.Sprintf("username must be %d to %d characters long", config.UsernameMinLength, config.UsernameMaxLength)})
+	}
+
+	mutex.RLock()
+	pattern := usernamePattern
+	mutex.RUnlock()
+	if pattern != nil && !pattern.MatchString(username) {
+		violations = append(violations, Violation{FieldUsername, RuleCharset, "username contains characters that are not allowed"})
+	}
+
+	//deleted-<id> - имена закрытых аккаунтов
+	key := UsernameKey(username)
+	reserved := strings.HasPrefix(key, "deleted-")
+	for _, name := range config.ReservedUsernames {
+		reserved = reserved || key == UsernameKey(name)
+	}
+	if reserved {
+		violations = append(violations, Violation{FieldUsername, RuleReserved, "username is reserved"})
+	}
+	return username, violations
+}
+
+// TeamName нормализует имя команды. Оно может содержать пробелы и любые печатные символы
+func TeamName(name string) (string, []Violation) {
+	name = strings.TrimSpace(NormalizeUsername(name))
+	if name == "" {
+		return "", []Violation{{FieldTeamName, RuleRequired, "team name is required"}}
+	}
+
+	violations := make([]Violation, 0)
+	if utf8.RuneCountInString(name) > maxTeamNameLength {
+		violations = append(violations, Violation{FieldTeamName, RuleLength,
+			fmt.Sprintf("team name must be at most %d characters long", maxTeamNameLength)})
+	}
+	if strings.IndexFunc(name, func(r rune) bool { return !unicode.IsPrint(r) && r != ' ' }) >= 0 {
+		violations = append(violations, Violation{FieldTeamName, RuleCharset, "team name contains characters that are not allowed"})
+	}
+	return name, violations
+}
+
+// Password проверяет пароль по правилам PASSWORD_*. username - имя владельца,
+// пароль не должен его содержать
+func Password(password string, username string) []Violation {
+	if password == "" {
+		return []Violation{{FieldPassword, RuleRequired, "password is required"}}
+	}
+
+	violations := make([]Violation, 0)
+	length := int64(utf8.RuneCountInString(password))
+	if length < config.PasswordMinLength || length > config.PasswordMaxLength {
+		violations = append(violations, Violation{FieldPassword, RuleLength,
+			fmt.Sprintf("password must be %d to %d characters long", config.PasswordMinLength, config.PasswordMaxLength)})
+	}
+
+	if characterClasses(password) < config.PasswordMinClasses {
+		violations = append(violations, Violation{FieldPassword, RuleWeak,
+			fmt.Sprintf("password must mix at least %d of lowercase letters, uppercase letters, digits and symbols", config.PasswordMinClasses)})
+	}
+	if distinctRunes(password) < minDistinctRunes {
+		violations = append(violations, Violation{FieldPassword, RuleWeak, "password has too few different characters"})
+	}
+	if username != "" && strings.Contains(UsernameKey(password), UsernameKey(username)) {
+		violations = append(violations, Violation{FieldPassword, RuleWeak, "password must not contain the username"})
+	}
+
+	mutex.RLock()
+	list := breaches
+	mutex.RUnlock()
+	if list != nil {
+		//Недоступный список не должен закрывать смену пароля, поэтому ошибка только пишется в журнал
+		breached, err := list.Contains(password)
+		if err != nil {
+			log.Printf("breach list: %s", err)
+		}
+		if breached {
+			violations = append(violations, Violation{FieldPassword, RuleBreached, "password appears in a list of breached passwords"})
+		}
+	}
+	return violations
+}
+
+// Email проверяет адрес для сброса пароля. Принимается только голый адрес без имени:
+// "user@example.com". Пустая строка допустима и означает отсутствие адреса
+func Email(email string) (string, []Violation) {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return "", nil
+	}
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email || len(email) > maxEmailLength {
+		return "", []Violation{{FieldEmail, RuleFormat, "email must be a plain address like user@example.com"}}
+	}
+	return email, nil
+}
+
+func characterClasses(password string) int64 {
+	var lower, upper, digit, other int64
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = 1
+		case unicode.IsUpper(r):
+			upper = 1
+		case unicode.IsDigit(r):
+			digit = 1
+		default:
+			other = 1
+		}
+	}
+	return lower + upper + digit + other
+}
+
+func distinctRunes(password string) int {
+	seen := make(map[rune]struct{})
+	for _, r := range password {
+		seen[r] = struct{}{}
+	}
+	return len(seen)
+}
@@ -0,0 +1,5 @@
+// Package client - типизированный Go-клиент REST API pasteGo.
+// Код генерируется из backend/api/openapi/openapi.yaml, после изменения спецификации: go generate ./client
+package client
+
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.4.1 -config oapi-codegen.yaml ../backend/api/openapi/openapi.yaml
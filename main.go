@@ -1,13 +1,24 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"os"
+	"pasteGo/backend/api/openapi"
 	"pasteGo/backend/api/rest/middlewares"
 	"pasteGo/backend/api/rest/v1/handlers"
 	"pasteGo/backend/api/rest/v1/types"
+	"pasteGo/backend/cli"
+	"pasteGo/backend/config"
 	"pasteGo/backend/db"
+	"pasteGo/backend/expiry"
+	"pasteGo/backend/mail"
+	"pasteGo/backend/ratelimit"
+	"pasteGo/backend/retention"
+	"pasteGo/backend/secrets"
+	"pasteGo/backend/validation"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
@@ -26,7 +37,39 @@ func main() {
 		log.Fatalf("Ошибка при инициализации базы данных: %s", err)
 	}
 
+	//Административные команды: pasteGo import ...
+	if len(os.Args) > 1 {
+		if err := cli.Run(os.Args[1:]); err != nil {
+			log.Fatalf("Ошибка при выполнении команды: %s", err)
+		}
+		return
+	}
+
+	router, err := setupRouter()
+	if err != nil {
+		log.Fatalf("Ошибка в TRUSTED_PROXIES: %s", err)
+	}
+
+	//Каждый маршрут должен быть описан в backend/api/openapi/openapi.yaml, это проверяет main_test.go
+	missing, err := openapi.Undocumented(router.Routes())
+	if err != nil {
+		log.Fatalf("Ошибка в спецификации OpenAPI: %s", err)
+	}
+	if len(missing) > 0 {
+		log.Printf("Маршруты без описания в OpenAPI: %s", strings.Join(missing, ", "))
+	}
+
+	retention.Start(config.RetentionInterval)
+
+	router.Run("0.0.0.0:10015")
+}
+
+// setupRouter регистрирует все маршруты приложения. Ошибка - неверный TRUSTED_PROXIES
+func setupRouter() (*gin.Engine, error) {
 	router := gin.Default()
+	if err := router.SetTrustedProxies(config.TrustedProxies); err != nil {
+		return nil, err
+	}
 
 	router.Static("/_app/immutable/", "./build/_app/immutable/")
 	router.NoRoute(func(c *gin.Context) {
@@ -42,14 +85,28 @@ func main() {
 		})
 	})
 
+	router.GET("/render/:id", handlers.RenderPaste)
+
 	rest := router.Group("/rest")
 	{
+		rest.GET("/openapi.json", handlers.GetOpenAPI)
+
 		rest.POST("/auth", handlers.Login)
+		rest.POST("/auth/2fa", handlers.LoginTwoFactor)
+		rest.GET("/oidc/login", handlers.OIDCLogin)
+		rest.GET("/oidc/callback", handlers.OIDCCallback)
 		rest.POST("/registration", handlers.Register)
+		rest.POST("/password/reset", handlers.RequestPasswordReset)
+		rest.POST("/password/reset/confirm", handlers.ConfirmPasswordReset)
 		rest.DELETE("/logout", handlers.Logout)
 		rest.POST("/update_tokens", middlewares.JwtRefreshMiddleware(), handlers.Refresh)
 
 		rest.POST("/paste/:id", handlers.GetPaste)
+		rest.GET("/paste/:id/raw", handlers.GetPasteRaw)
+		rest.GET("/paste/:id/raw/:name", handlers.GetPasteFileRaw)
+		rest.GET("/paste/:id/zip", handlers.GetPasteZip)
+		rest.GET("/paste/:id/attachments/:attachmentId", handlers.DownloadAttachment)
+		rest.GET("/paste/:id/forks", handlers.GetPasteForks)
 
 		v1 := rest.Group("/v1", middlewares.JwtMiddleware())
 		{
@@ -61,15 +118,157 @@ func main() {
 
 			v1.PUT("/user", handlers.UpdateUser)
 			v1.DELETE("/user", handlers.DeleteUser)
+			v1.GET("/user/quota", handlers.GetQuota)
+			v1.GET("/user/email", handlers.GetEmail)
+			v1.PUT("/user/email", handlers.SetEmail)
+			v1.GET("/user/2fa", handlers.GetTwoFactor)
+			v1.POST("/user/2fa", handlers.EnrollTwoFactor)
+			v1.POST("/user/2fa/verify", handlers.ConfirmTwoFactor)
+			v1.DELETE("/user/2fa", handlers.DisableTwoFactor)
+			v1.POST("/user/2fa/recovery-codes", handlers.RegenerateRecoveryCodes)
+			v1.GET("/user/identities", handlers.GetIdentities)
+			v1.GET("/user/oidc/link", handlers.LinkOIDC)
+			v1.GET("/user/shared-pastes", handlers.GetSharedPastes)
 
 			v1.GET("/paste", handlers.GetPasteList)
-			v1.POST("/paste", handlers.CreatePaste)
-			v1.PUT("/paste/:id", handlers.UpdatePaste)
+			v1.POST("/paste", middlewares.BodyLimitMiddleware(config.MaxPasteSize), handlers.CreatePaste)
+			v1.PUT("/paste/:id", middlewares.BodyLimitMiddleware(config.MaxPasteSize), handlers.UpdatePaste)
+			v1.PATCH("/paste/:id", middlewares.BodyLimitMiddleware(config.MaxPasteSize), handlers.PatchPaste)
+			v1.PUT("/paste/:id/expiry", handlers.SetPasteExpiry)
 			v1.DELETE("/paste/:id", handlers.DeletePaste)
+			v1.POST("/paste/:id/attachments", handlers.UploadAttachment)
+			v1.DELETE("/paste/:id/attachments/:attachmentId", handlers.DeleteAttachment)
+			v1.POST("/paste/:id/fork", handlers.ForkPaste)
+			v1.GET("/paste/:id/shares", handlers.GetPasteShares)
+			v1.POST("/paste/:id/shares", handlers.SharePaste)
+			v1.DELETE("/paste/:id/shares/:shareId", handlers.UnsharePaste)
+			v1.GET("/paste/:id/links", handlers.GetShareLinks)
+			v1.POST("/paste/:id/links", handlers.CreateShareLink)
+			v1.DELETE("/paste/:id/links/:linkId", handlers.RevokeShareLink)
+
+			v1.GET("/teams", handlers.GetTeams)
+			v1.POST("/teams", handlers.CreateTeam)
+			v1.GET("/teams/:teamId", handlers.GetTeam)
+			v1.DELETE("/teams/:teamId", handlers.DeleteTeam)
+			v1.PUT("/teams/:teamId/members/:username", handlers.SetTeamMember)
+			v1.DELETE("/teams/:teamId/members/:username", handlers.RemoveTeamMember)
+			v1.GET("/teams/:teamId/pastes", handlers.GetTeamPastes)
+
+			v1.POST("/import/:format", handlers.ImportPastes)
+
+			admin := v1.Group("/admin", middlewares.AdminMiddleware())
+			{
+				admin.GET("/users/:username/quota", handlers.GetUserQuota)
+				admin.PUT("/users/:username/quota", handlers.SetUserQuota)
+				admin.DELETE("/users/:username/quota", handlers.ResetUserQuota)
+				admin.DELETE("/users/:username/2fa", handlers.ResetTwoFactor)
+				admin.POST("/users/:username/password-reset", handlers.IssuePasswordReset)
+
+				admin.GET("/retention/policies", handlers.GetRetentionPolicies)
+				admin.POST("/retention/policies", handlers.AddRetentionPolicy)
+				admin.DELETE("/retention/policies/:policyId", handlers.DeleteRetentionPolicy)
+				admin.GET("/retention/events", handlers.GetRetentionEvents)
+				admin.POST("/retention/run", handlers.RunRetention)
+				admin.PUT("/pastes/:id/hold", handlers.SetLegalHold)
+				admin.DELETE("/pastes/:id/hold", handlers.ReleaseLegalHold)
+
+				admin.GET("/audit", handlers.GetAuditEvents)
+				admin.GET("/audit/export", handlers.ExportAuditEvents)
+
+				admin.GET("/lockouts", handlers.GetLockouts)
+				admin.DELETE("/lockouts/:key", handlers.ResetLockout)
+			}
 		}
 	}
 
-	router.Run("0.0.0.0:10015")
+	//v2: GET для чтения, пароль вставки в X-Paste-Password, ошибки в формате problem+json
+	restV2 := router.Group("/rest/v2", middlewares.ProblemMiddleware())
+	{
+		restV2.POST("/session", handlers.Login)
+		restV2.POST("/session/2fa", handlers.LoginTwoFactor)
+		restV2.GET("/session/oidc", handlers.OIDCLogin)
+		restV2.GET("/session/oidc/callback", handlers.OIDCCallback)
+		restV2.DELETE("/session", handlers.Logout)
+		restV2.POST("/session/refresh", middlewares.JwtRefreshMiddleware(), handlers.Refresh)
+		restV2.POST("/users", handlers.Register)
+		restV2.POST("/password-resets", handlers.RequestPasswordReset)
+		restV2.POST("/password-resets/confirm", handlers.ConfirmPasswordReset)
+
+		restV2.GET("/pastes/:id", handlers.ReadPaste)
+		restV2.GET("/pastes/:id/raw", handlers.GetPasteRaw)
+		restV2.GET("/pastes/:id/raw/:name", handlers.GetPasteFileRaw)
+		restV2.GET("/pastes/:id/zip", handlers.GetPasteZip)
+		restV2.GET("/pastes/:id/forks", handlers.GetPasteForks)
+		restV2.GET("/pastes/:id/attachments/:attachmentId", handlers.DownloadAttachment)
+
+		authorized := restV2.Group("", middlewares.JwtMiddleware())
+		{
+			authorized.PATCH("/user", handlers.UpdateUser)
+			authorized.DELETE("/user", handlers.DeleteUser)
+			authorized.GET("/user/quota", handlers.GetQuota)
+			authorized.GET("/user/email", handlers.GetEmail)
+			authorized.PUT("/user/email", handlers.SetEmail)
+			authorized.GET("/user/2fa", handlers.GetTwoFactor)
+			authorized.POST("/user/2fa", handlers.EnrollTwoFactor)
+			authorized.POST("/user/2fa/verify", handlers.ConfirmTwoFactor)
+			authorized.DELETE("/user/2fa", handlers.DisableTwoFactor)
+			authorized.POST("/user/2fa/recovery-codes", handlers.RegenerateRecoveryCodes)
+			authorized.GET("/user/identities", handlers.GetIdentities)
+			authorized.GET("/user/oidc/link", handlers.LinkOIDC)
+			authorized.GET("/user/shared-pastes", handlers.GetSharedPastes)
+
+			authorized.GET("/pastes", handlers.GetPasteList)
+			authorized.POST("/pastes", middlewares.BodyLimitMiddleware(config.MaxPasteSize), handlers.CreatePaste)
+			authorized.PUT("/pastes/:id", middlewares.BodyLimitMiddleware(config.MaxPasteSize), handlers.UpdatePaste)
+			authorized.PATCH("/pastes/:id", middlewares.BodyLimitMiddleware(config.MaxPasteSize), handlers.PatchPaste)
+			authorized.PUT("/pastes/:id/expiry", handlers.SetPasteExpiry)
+			authorized.DELETE("/pastes/:id", handlers.DeletePaste)
+			authorized.POST("/pastes/:id/attachments", handlers.UploadAttachment)
+			authorized.DELETE("/pastes/:id/attachments/:attachmentId", handlers.DeleteAttachment)
+			authorized.POST("/pastes/:id/forks", handlers.ForkPaste)
+			authorized.GET("/pastes/:id/shares", handlers.GetPasteShares)
+			authorized.POST("/pastes/:id/shares", handlers.SharePaste)
+			authorized.DELETE("/pastes/:id/shares/:shareId", handlers.UnsharePaste)
+			authorized.GET("/pastes/:id/links", handlers.GetShareLinks)
+			authorized.POST("/pastes/:id/links", handlers.CreateShareLink)
+			authorized.DELETE("/pastes/:id/links/:linkId", handlers.RevokeShareLink)
+
+			authorized.GET("/teams", handlers.GetTeams)
+			authorized.POST("/teams", handlers.CreateTeam)
+			authorized.GET("/teams/:teamId", handlers.GetTeam)
+			authorized.DELETE("/teams/:teamId", handlers.DeleteTeam)
+			authorized.PUT("/teams/:teamId/members/:username", handlers.SetTeamMember)
+			authorized.DELETE("/teams/:teamId/members/:username", handlers.RemoveTeamMember)
+			authorized.GET("/teams/:teamId/pastes", handlers.GetTeamPastes)
+
+			authorized.POST("/imports/:format", handlers.ImportPastes)
+
+			admin := authorized.Group("/admin", middlewares.AdminMiddleware())
+			{
+				admin.GET("/users/:username/quota", handlers.GetUserQuota)
+				admin.PUT("/users/:username/quota", handlers.SetUserQuota)
+				admin.DELETE("/users/:username/quota", handlers.ResetUserQuota)
+				admin.DELETE("/users/:username/2fa", handlers.ResetTwoFactor)
+				admin.POST("/users/:username/password-reset", handlers.IssuePasswordReset)
+
+				admin.GET("/retention/policies", handlers.GetRetentionPolicies)
+				admin.POST("/retention/policies", handlers.AddRetentionPolicy)
+				admin.DELETE("/retention/policies/:policyId", handlers.DeleteRetentionPolicy)
+				admin.GET("/retention/events", handlers.GetRetentionEvents)
+				admin.POST("/retention/run", handlers.RunRetention)
+				admin.PUT("/pastes/:id/hold", handlers.SetLegalHold)
+				admin.DELETE("/pastes/:id/hold", handlers.ReleaseLegalHold)
+
+				admin.GET("/audit", handlers.GetAuditEvents)
+				admin.GET("/audit/export", handlers.ExportAuditEvents)
+
+				admin.GET("/lockouts", handlers.GetLockouts)
+				admin.DELETE("/lockouts/:key", handlers.ResetLockout)
+			}
+		}
+	}
+
+	return router, nil
 }
 
 func importENV() {
@@ -84,5 +283,24 @@ func importENV() {
 	}
 
 	types.SecretKey = []byte(secret)
+
+	if err := config.Load(); err != nil {
+		log.Fatalf("Ошибка в настройках: %s", err)
+	}
+	if err := secrets.LoadRules(config.SecretScanRules); err != nil {
+		log.Fatalf("Ошибка в правилах поиска секретов: %s", err)
+	}
+	if err := validation.Load(); err != nil {
+		log.Fatalf("Ошибка в правилах имён и паролей: %s", err)
+	}
+	if err := expiry.SetMaximum(config.MaxPasteLifetime); err != nil {
+		log.Fatalf("Ошибка в MAX_PASTE_LIFETIME: %s", err)
+	}
+	if _, err := ratelimit.GetLimiter(); err != nil {
+		log.Fatalf("Ошибка в RATE_LIMIT_STORE: %s", err)
+	}
+	if _, err := mail.GetMailer(); err != nil && !errors.Is(err, mail.ErrDisabled) {
+		log.Fatalf("Ошибка в MAIL_TRANSPORT: %s", err)
+	}
 	fmt.Println(secret)
 }
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"pasteGo/backend/api/openapi"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Каждый зарегистрированный маршрут должен быть описан в backend/api/openapi/openapi.yaml
+func TestRoutesDocumented(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router, err := setupRouter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	routes := router.Routes()
+	if len(routes) == 0 {
+		t.Fatal("no routes registered")
+	}
+
+	missing, err := openapi.Undocumented(routes)
+	if err != nil {
+		t.Fatalf("OpenAPI spec: %v", err)
+	}
+	if len(missing) > 0 {
+		t.Errorf("routes missing from the OpenAPI spec:\n%s", strings.Join(missing, "\n"))
+	}
+}